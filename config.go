@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+	"tsqlgrl/tsql"
+)
+
+/* Layout of the mapping config passed with -config, every part is optional
+ *
+ *	{
+ *	  "types": { "rules": [{ "type": "NUMBER", "maxPrecision": 0, "target": "DECIMAL(38, 10)" }] },
+ *	  "schemas": { "HR": "dbo" },
+ *	  "principals": { "APP_RW": "app_rw_role" },
+ *	  "filegroups": { "USERS": "PRIMARY" },
+ *	  "temporaryTables": "session"
+ *	}
+ */
+type Config struct {
+	Types *tsql.TypeMapFile `json:"types,omitempty"`
+	// schema renames, keys are matched case insensitively
+	Schemas map[string]string `json:"schemas,omitempty"`
+	// oracle users and roles to sql server principals
+	Principals map[string]string `json:"principals,omitempty"`
+	// tablespace -> filegroup
+	Filegroups map[string]string `json:"filegroups,omitempty"`
+	// one of tsql.TempTableModes
+	TemporaryTables string `json:"temporaryTables,omitempty"`
+}
+
+/* Reads a mapping config and applies it to the conversion settings */
+func LoadConfig(fpath string) error {
+	bs, err := os.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	var config Config
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(&config)
+	if err != nil {
+		return generic.Errorf(err, "error while reading mapping config from %s", fpath)
+	}
+
+	if config.Types != nil {
+		types, err := config.Types.TypeMap(fpath)
+		if err != nil {
+			return err
+		}
+		Types = types
+	}
+	if config.TemporaryTables != "" {
+		if !slices.Contains(tsql.TempTableModes, config.TemporaryTables) {
+			return fmt.Errorf("unknown temporary table mode %q in %s, expected one of %s", config.TemporaryTables, fpath, strings.Join(tsql.TempTableModes, ", "))
+		}
+		TempTables = config.TemporaryTables
+	}
+	if SchemaMap, err = upperKeys(config.Schemas, "schemas", fpath); err != nil {
+		return err
+	}
+	if PrincipalMap, err = upperKeys(config.Principals, "principals", fpath); err != nil {
+		return err
+	}
+	if Filegroups, err = upperKeys(config.Filegroups, "filegroups", fpath); err != nil {
+		return err
+	}
+	return nil
+}
+
+/* Upper cases the keys of a name mapping, the serializer looks names up by their upper case form
+ * keys that only differ in case are rejected, either target could win otherwise
+ */
+func upperKeys(mapping map[string]string, section string, fpath string) (map[string]string, error) {
+	if mapping == nil {
+		return nil, nil
+	}
+	results := map[string]string{}
+	keys := map[string]string{}
+	for from, to := range mapping {
+		key := strings.ToUpper(from)
+		if other, ok := keys[key]; ok {
+			first, second := min(other, from), max(other, from)
+			return nil, fmt.Errorf("%s in %s maps both %q and %q, keys are matched case insensitively", section, fpath, first, second)
+		}
+		keys[key] = from
+		results[key] = to
+	}
+	return results, nil
+}
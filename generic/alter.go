@@ -0,0 +1,188 @@
+package generic
+
+import (
+	"fmt"
+	"slices"
+)
+
+const ALTER_ADD_COLUMN string = "ADD COLUMN"
+const ALTER_MODIFY_COLUMN string = "MODIFY COLUMN"
+const ALTER_ADD_CONSTRAINT string = "ADD CONSTRAINT"
+const ALTER_MODIFY_CONSTRAINT string = "MODIFY CONSTRAINT"
+const ALTER_DROP_CONSTRAINT string = "DROP CONSTRAINT"
+
+type AlterAction struct {
+	// one of the ALTER_ constants
+	Kind string
+	// added column, or the changed parts of a modified column
+	Column *ColumnDef `json:",omitempty"`
+	// added constraint, or the Name / Kind of the constraint to modify or drop
+	Constraint *ConstraintDef `json:",omitempty"`
+	// state keywords of a modified constraint, e.g. DISABLE or ENABLE NOVALIDATE
+	State    []string `json:",omitempty"`
+	Position Position `json:",omitzero"`
+}
+
+type AlterTable struct {
+	Table    QualifiedName
+	Actions  []*AlterAction
+	Position Position `json:",omitzero"`
+}
+
+/* Applies state keywords the way oracle does
+ * ENABLE implies VALIDATE and DISABLE implies NOVALIDATE unless stated otherwise
+ */
+func (s *ConstraintState) Apply(keywords ...string) {
+	enable := ""
+	validate := ""
+	for _, keyword := range keywords {
+		switch keyword {
+		case "ENABLE", "DISABLE":
+			enable = keyword
+		case "VALIDATE", "NOVALIDATE":
+			validate = keyword
+		case "RELY":
+			s.Rely = true
+		case "NORELY":
+			s.Rely = false
+		case "DEFERRABLE":
+			s.Deferrable = true
+		case "NOT DEFERRABLE":
+			s.Deferrable = false
+		case "INITIALLY DEFERRED":
+			s.InitiallyDeferred = true
+		case "INITIALLY IMMEDIATE":
+			s.InitiallyDeferred = false
+		}
+	}
+	switch enable {
+	case "ENABLE":
+		s.Disabled = false
+		s.NoValidate = validate == "NOVALIDATE"
+	case "DISABLE":
+		s.Disabled = true
+		s.NoValidate = validate != "VALIDATE"
+	default:
+		if validate != "" {
+			s.NoValidate = validate == "NOVALIDATE"
+		}
+	}
+}
+
+/* Adds inline constraints to a column
+ * NULL / NOT NULL update the column's nullability, the rest are bound to the column
+ */
+func (c *ColumnDef) AddConstraints(cons ...*ConstraintDef) {
+	for _, con := range cons {
+		switch con.Kind {
+		case CONSTRAINT_NOT_NULL, CONSTRAINT_NULL:
+			// a later NULL / NOT NULL replaces the earlier one
+			c.Constraints = slices.DeleteFunc(c.Constraints, func(old *ConstraintDef) bool {
+				return old.Kind == CONSTRAINT_NOT_NULL || old.Kind == CONSTRAINT_NULL
+			})
+			c.NotNull = con.Kind == CONSTRAINT_NOT_NULL && !con.State.Disabled
+		default:
+			con.Columns = []string{c.Name}
+		}
+		c.Constraints = append(c.Constraints, con)
+	}
+}
+
+/* Applies the declared parts of a MODIFY column to an existing column */
+func (c *ColumnDef) Modify(m *ColumnDef) {
+	if m.Type.Name != "" {
+		// the column is declared by the MODIFY from now on, so problems with its type point there
+		c.Type = m.Type
+		c.Position = m.Position
+	}
+	if m.Default != nil {
+		c.Default = m.Default
+	}
+	if m.Identity != nil {
+		c.Identity = m.Identity
+	}
+	c.AddConstraints(m.Constraints...)
+}
+
+/* Finds a constraint by name, out of line or inline
+ * returns the column owning it when it is inline
+ */
+func (t *TableDef) FindConstraint(name string) (*ConstraintDef, *ColumnDef) {
+	for _, con := range t.Constraints {
+		if con.Name == name {
+			return con, nil
+		}
+	}
+	for _, c := range t.Columns {
+		for _, con := range c.Constraints {
+			if con.Name == name {
+				return con, c
+			}
+		}
+	}
+	return nil, nil
+}
+
+/* Removes a constraint by name, or the primary key when the name is empty */
+func (t *TableDef) DropConstraint(target *ConstraintDef) error {
+	matches := func(con *ConstraintDef) bool {
+		if target.Name != "" {
+			return con.Name == target.Name
+		}
+		return con.Kind == target.Kind && (len(target.Columns) == 0 || slices.Equal(con.Columns, target.Columns))
+	}
+
+	found := false
+	t.Constraints = slices.DeleteFunc(t.Constraints, func(con *ConstraintDef) bool {
+		found = found || matches(con)
+		return matches(con)
+	})
+	for _, c := range t.Columns {
+		c.Constraints = slices.DeleteFunc(c.Constraints, func(con *ConstraintDef) bool {
+			if !matches(con) {
+				return false
+			}
+			found = true
+			if con.Kind == CONSTRAINT_NOT_NULL {
+				c.NotNull = false
+			}
+			return true
+		})
+	}
+	if !found {
+		return fmt.Errorf("no constraint %s %s on table %s", target.Kind, target.Name, t.Name)
+	}
+	return nil
+}
+
+func (t *TableDef) Alter(a *AlterAction) error {
+	switch a.Kind {
+	case ALTER_ADD_COLUMN:
+		if t.Columns.Get(a.Column.Name) != nil {
+			return fmt.Errorf("column %s already exists on table %s", a.Column.Name, t.Name)
+		}
+		t.Columns.Add(a.Column)
+	case ALTER_MODIFY_COLUMN:
+		c := t.Columns.Get(a.Column.Name)
+		if c == nil {
+			return fmt.Errorf("no column %s on table %s", a.Column.Name, t.Name)
+		}
+		c.Modify(a.Column)
+	case ALTER_ADD_CONSTRAINT:
+		t.Constraints = append(t.Constraints, a.Constraint)
+	case ALTER_MODIFY_CONSTRAINT:
+		con, c := t.FindConstraint(a.Constraint.Name)
+		if con == nil {
+			return fmt.Errorf("no constraint %s on table %s", a.Constraint.Name, t.Name)
+		}
+		con.State.Apply(a.State...)
+		if c != nil && con.Kind == CONSTRAINT_NOT_NULL {
+			c.NotNull = !con.State.Disabled
+		}
+	case ALTER_DROP_CONSTRAINT:
+		return t.DropConstraint(a.Constraint)
+	default:
+		return fmt.Errorf("unsupported alter table action %q", a.Kind)
+	}
+	return nil
+}
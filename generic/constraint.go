@@ -0,0 +1,85 @@
+package generic
+
+const CONSTRAINT_NOT_NULL string = "NOT NULL"
+const CONSTRAINT_NULL string = "NULL"
+const CONSTRAINT_PRIMARY_KEY string = "PRIMARY KEY"
+const CONSTRAINT_UNIQUE string = "UNIQUE"
+const CONSTRAINT_FOREIGN_KEY string = "FOREIGN KEY"
+const CONSTRAINT_CHECK string = "CHECK"
+
+/* A physical or storage attribute, e.g. PCTFREE 10 or STORAGE(INITIAL 65536)
+ * Value is empty for flags like NOLOGGING
+ */
+type PhysicalOption struct {
+	Name  string
+	Value string `json:",omitempty"`
+}
+
+func (o PhysicalOption) String() string {
+	if o.Value == "" {
+		return o.Name
+	}
+	if o.Name == "STORAGE" {
+		return o.Name + "(" + o.Value + ")"
+	}
+	return o.Name + " " + o.Value
+}
+
+/* Index backing a primary key or unique constraint
+ * either an existing index by Name, a full CREATE INDEX in Statement, or physical attributes
+ */
+type UsingIndexDef struct {
+	Name       QualifiedName `json:",omitzero"`
+	Statement  string        `json:",omitempty"`
+	Tablespace string        `json:",omitempty"`
+	// physical attributes other than the tablespace
+	Options []PhysicalOption `json:",omitempty"`
+}
+
+/* State clauses that can follow a constraint
+ * zero value is an enabled, validated, non deferrable constraint
+ */
+type ConstraintState struct {
+	Disabled          bool           `json:",omitempty"`
+	NoValidate        bool           `json:",omitempty"`
+	Rely              bool           `json:",omitempty"`
+	Deferrable        bool           `json:",omitempty"`
+	InitiallyDeferred bool           `json:",omitempty"`
+	UsingIndex        *UsingIndexDef `json:",omitempty"`
+}
+
+type ConstraintDef struct {
+	Name string `json:",omitempty"`
+	// one of the CONSTRAINT_ constants
+	Kind    string
+	Columns []string `json:",omitempty"`
+	// referenced table and columns of a foreign key
+	RefTable   QualifiedName `json:",omitzero"`
+	RefColumns []string      `json:",omitempty"`
+	// CASCADE or SET NULL, empty when the source didn't specify one
+	DeleteRule string `json:",omitempty"`
+	// condition of a check constraint without the enclosing parens
+	Check    *Expression     `json:",omitempty"`
+	State    ConstraintState `json:",omitempty"`
+	Position Position        `json:",omitzero"`
+}
+
+/* Returns the enabled primary key, inline or out of line, nil when there is none */
+func (t *TableDef) PrimaryKey() *ConstraintDef {
+	isKey := func(con *ConstraintDef) bool {
+		return con.Kind == CONSTRAINT_PRIMARY_KEY && !con.State.Disabled
+	}
+	for _, con := range t.Constraints {
+		if isKey(con) {
+			return con
+		}
+	}
+	for _, c := range t.Columns {
+		for _, con := range c.Constraints {
+			if isKey(con) {
+				return con
+			}
+		}
+	}
+	return nil
+}
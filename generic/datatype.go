@@ -0,0 +1,72 @@
+package generic
+
+import (
+	"fmt"
+	"strings"
+)
+
+/* A column type as declared
+ * sizes that weren't declared are 0, except the fractional seconds and interval precisions
+ * which get oracle's defaults from the parser
+ */
+type DataType struct {
+	// base type without arguments, e.g. NUMBER, LONG RAW, INTERVAL DAY TO SECOND, or SCHEMA.NAME of an object type
+	Name string
+	// length of character and RAW types
+	Length int `json:",omitempty"`
+	// BYTE or CHAR when the length was declared with explicit length semantics
+	LengthSemantics string `json:",omitempty"`
+	// NUMBER, DECIMAL and FLOAT precision, leading field precision of intervals
+	Precision int `json:",omitempty"`
+	Scale     int `json:",omitempty"`
+	// true when a scale was declared, so NUMBER(*,0) can be told apart from NUMBER
+	Scaled bool `json:",omitempty"`
+	// digits after the second of TIMESTAMP and INTERVAL DAY TO SECOND
+	FractionalSeconds int `json:",omitempty"`
+	// TIME ZONE or LOCAL TIME ZONE for TIMESTAMP WITH [LOCAL] TIME ZONE
+	TimeZone string `json:",omitempty"`
+	// true for object types created with CREATE TYPE
+	UserDefined bool `json:",omitempty"`
+}
+
+/* Base type including the time zone flavor, e.g. TIMESTAMP WITH LOCAL TIME ZONE
+ * this is the name type rules are matched against
+ */
+func (t DataType) FullName() string {
+	if t.TimeZone != "" {
+		return t.Name + " WITH " + t.TimeZone
+	}
+	return t.Name
+}
+
+/* Writes the type the way oracle declares it, e.g. NUMBER(10,2) or TIMESTAMP(3) WITH TIME ZONE */
+func (t DataType) String() string {
+	switch t.Name {
+	case "INTERVAL YEAR TO MONTH":
+		return fmt.Sprintf("INTERVAL YEAR(%d) TO MONTH", t.Precision)
+	case "INTERVAL DAY TO SECOND":
+		return fmt.Sprintf("INTERVAL DAY(%d) TO SECOND(%d)", t.Precision, t.FractionalSeconds)
+	case "TIMESTAMP":
+		result := fmt.Sprintf("TIMESTAMP(%d)", t.FractionalSeconds)
+		if t.TimeZone != "" {
+			result += " WITH " + t.TimeZone
+		}
+		return result
+	}
+	args := []string{}
+	switch {
+	case t.Length > 0:
+		args = append(args, strings.TrimSpace(fmt.Sprintf("%d %s", t.Length, t.LengthSemantics)))
+	case t.Precision > 0:
+		args = append(args, fmt.Sprint(t.Precision))
+		if t.Scaled {
+			args = append(args, fmt.Sprint(t.Scale))
+		}
+	case t.Scaled:
+		args = append(args, "*", fmt.Sprint(t.Scale))
+	}
+	if len(args) == 0 {
+		return t.Name
+	}
+	return t.Name + "(" + strings.Join(args, ",") + ")"
+}
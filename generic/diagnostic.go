@@ -0,0 +1,346 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const SEVERITY_ERROR string = "error"
+const SEVERITY_WARNING string = "warning"
+const SEVERITY_NOTE string = "note"
+
+// at most this many expected alternatives are listed when a diagnostic is formatted
+const DIAGNOSTIC_MAX_EXPECTED int = 8
+
+/* Something the parser or the conversion has to say about a script
+ * Line and Column are 1 based and 0 when the diagnostic is about the whole file
+ */
+type Diagnostic struct {
+	// one of the SEVERITY_ constants
+	Severity string
+	File     string `json:",omitempty"`
+	Line     int    `json:",omitempty"`
+	Column   int    `json:",omitempty"`
+	Message  string
+	// source line Line points at
+	Snippet string `json:",omitempty"`
+	// what the parser would have accepted at Line and Column, as written in the grammar
+	Expected []string `json:",omitempty"`
+}
+
+type Diagnostics []*Diagnostic
+
+/* Where a statement or column starts in its script, zero when it didn't come from one
+ * File is the script it is in, it differs from the converted one for statements read from an include
+ */
+type Position struct {
+	File   string `json:",omitempty"`
+	Line   int    `json:",omitempty"`
+	Column int    `json:",omitempty"`
+}
+
+func (p Position) IsZero() bool {
+	return p.Line == 0
+}
+
+/* Ties err to the position, nil stays nil and a zero position leaves err as it is */
+func (p Position) Wrap(err error) error {
+	if err == nil || p.IsZero() {
+		return err
+	}
+	return &PositionError{Position: p, Err: err}
+}
+
+/* Errorf at the position */
+func (p Position) Errorf(err error, format string, a ...any) error {
+	return p.Wrap(Errorf(err, format, a...))
+}
+
+/* An error at a position in a script, ErrorDiagnostics places the diagnostic at the innermost one */
+type PositionError struct {
+	Position Position
+	Err      error
+}
+
+func (e *PositionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PositionError) Unwrap() error {
+	return e.Err
+}
+
+/* Diagnostic about source at a line and column, the snippet is taken from source */
+func NewDiagnostic(severity string, file string, source []byte, line int, column int, message string) *Diagnostic {
+	return &Diagnostic{
+		Severity: severity,
+		File:     file,
+		Line:     line,
+		Column:   column,
+		Message:  message,
+		Snippet:  SourceLine(source, line),
+	}
+}
+
+/* Turns an error into diagnostics
+ * diagnostics passed through as errors are kept as they are and joins of them are split up again,
+ * any other error is a single failure and becomes one diagnostic with its message on one line
+ */
+func ErrorDiagnostics(severity string, file string, err error) Diagnostics {
+	if err == nil {
+		return nil
+	}
+	if d, ok := err.(*Diagnostic); ok {
+		if d.File == "" {
+			d.File = file
+		}
+		return Diagnostics{d}
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok && joinsDiagnostics(joined.Unwrap()) {
+		results := Diagnostics{}
+		for _, e := range joined.Unwrap() {
+			results = append(results, ErrorDiagnostics(severity, file, e)...)
+		}
+		return results
+	}
+	result := &Diagnostic{Severity: severity, File: file, Message: strings.ReplaceAll(err.Error(), "\n", "; ")}
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if pe, ok := e.(*PositionError); ok {
+			result.Line = pe.Position.Line
+			result.Column = pe.Position.Column
+			if pe.Position.File != "" {
+				result.File = pe.Position.File
+			}
+		}
+	}
+	return Diagnostics{result}
+}
+
+/* Tells if errs only holds diagnostics, possibly joined again */
+func joinsDiagnostics(errs []error) bool {
+	for _, e := range errs {
+		if _, ok := e.(*Diagnostic); ok {
+			continue
+		}
+		joined, ok := e.(interface{ Unwrap() []error })
+		if !ok || !joinsDiagnostics(joined.Unwrap()) {
+			return false
+		}
+	}
+	return true
+}
+
+/* Returns line n of source without its line break, empty when there is no such line */
+func SourceLine(source []byte, n int) string {
+	if n < 1 {
+		return ""
+	}
+	lines := strings.SplitN(string(source), "\n", n+1)
+	if len(lines) < n {
+		return ""
+	}
+	return strings.TrimRight(lines[n-1], "\r")
+}
+
+/* file:line:column prefix of the diagnostic, parts that aren't known are left out */
+func (d *Diagnostic) Position() string {
+	parts := []string{}
+	if d.File != "" {
+		parts = append(parts, d.File)
+	}
+	if d.Line > 0 {
+		parts = append(parts, fmt.Sprint(d.Line))
+		if d.Column > 0 {
+			parts = append(parts, fmt.Sprint(d.Column))
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+func (d *Diagnostic) Error() string {
+	if position := d.Position(); position != "" {
+		return fmt.Sprintf("%s: %s: %s", position, d.Severity, d.Message)
+	}
+	return fmt.Sprintf("%s: %s", d.Severity, d.Message)
+}
+
+/* Writes the diagnostic compiler style, with the source line and a caret under the column
+ *
+ *	a.sql:3:5: error: unexpected "TABEL"
+ *	CREATE TABEL T1 (
+ *	       ^
+ *	expected one of "TABLE", "INDEX"
+ */
+func (d *Diagnostic) Format() string {
+	var sb strings.Builder
+	sb.WriteString(d.Error() + "\n")
+	if d.Snippet != "" {
+		sb.WriteString(d.Snippet + "\n")
+		if d.Column > 0 {
+			sb.WriteString(caretLine(d.Snippet, d.Column) + "\n")
+		}
+	}
+	if len(d.Expected) > 0 {
+		expected := d.Expected
+		more := ""
+		if len(expected) > DIAGNOSTIC_MAX_EXPECTED {
+			more = fmt.Sprintf(" and %d more", len(expected)-DIAGNOSTIC_MAX_EXPECTED)
+			expected = expected[:DIAGNOSTIC_MAX_EXPECTED]
+		}
+		fmt.Fprintf(&sb, "expected one of %s%s\n", strings.Join(expected, ", "), more)
+	}
+	return sb.String()
+}
+
+/* Spaces up to the column and a caret, tabs are kept so the caret lines up with the snippet */
+func caretLine(snippet string, column int) string {
+	var sb strings.Builder
+	for i, r := range snippet {
+		if utf8.RuneCountInString(snippet[:i]) >= column-1 {
+			break
+		}
+		if r == '\t' {
+			sb.WriteRune('\t')
+		} else {
+			sb.WriteRune(' ')
+		}
+	}
+	for n := utf8.RuneCountInString(snippet); n < column-1; n++ {
+		sb.WriteRune(' ')
+	}
+	return sb.String() + "^"
+}
+
+/* Fills in the source line of diagnostics about file that have a position but no snippet yet */
+func (ds Diagnostics) Snippets(file string, source []byte) {
+	for _, d := range ds {
+		if d.File == file && d.Line > 0 && d.Snippet == "" {
+			d.Snippet = SourceLine(source, d.Line)
+		}
+	}
+}
+
+/* Joins the diagnostics into a single error, nil when there are none
+ * ErrorDiagnostics turns it back into the same diagnostics
+ */
+func (ds Diagnostics) Err() error {
+	errs := []error{}
+	for _, d := range ds {
+		errs = append(errs, d)
+	}
+	return errors.Join(errs...)
+}
+
+func (ds Diagnostics) HasErrors() bool {
+	return ds.Count(SEVERITY_ERROR) > 0
+}
+
+func (ds Diagnostics) Count(severity string) int {
+	result := 0
+	for _, d := range ds {
+		if d.Severity == severity {
+			result++
+		}
+	}
+	return result
+}
+
+/* Writes all diagnostics compiler style, the ones showing source are followed by an empty line */
+func (ds Diagnostics) Format() string {
+	var sb strings.Builder
+	for _, d := range ds {
+		text := d.Format()
+		sb.WriteString(text)
+		if strings.Count(text, "\n") > 1 {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+func (ds Diagnostics) JSON() ([]byte, error) {
+	if ds == nil {
+		ds = Diagnostics{}
+	}
+	return json.MarshalIndent(ds, "", " ")
+}
+
+/* SARIF 2.1.0 log, the subset code scanning tools and editors read */
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int           `json:"startLine"`
+	StartColumn int           `json:"startColumn,omitempty"`
+	Snippet     *sarifMessage `json:"snippet,omitempty"`
+}
+
+/* Writes the diagnostics as a SARIF log of a single run of tool */
+func (ds Diagnostics) SARIF(tool string) ([]byte, error) {
+	run := sarifRun{Tool: sarifTool{Driver: sarifDriver{Name: tool}}, Results: []sarifResult{}}
+	for _, d := range ds {
+		result := sarifResult{Level: d.Severity, Message: sarifMessage{Text: d.Message}}
+		if len(d.Expected) > 0 {
+			result.Message.Text += ", expected one of " + strings.Join(d.Expected, ", ")
+		}
+		if d.File != "" {
+			location := sarifLocation{PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: strings.ReplaceAll(d.File, "\\", "/")}}}
+			if d.Line > 0 {
+				region := &sarifRegion{StartLine: d.Line, StartColumn: d.Column}
+				if d.Snippet != "" {
+					region.Snippet = &sarifMessage{Text: d.Snippet}
+				}
+				location.PhysicalLocation.Region = region
+			}
+			result.Locations = append(result.Locations, location)
+		}
+		run.Results = append(run.Results, result)
+	}
+	return json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", " ")
+}
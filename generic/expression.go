@@ -0,0 +1,95 @@
+package generic
+
+const LITERAL_STRING string = "STRING"
+const LITERAL_NUMBER string = "NUMBER"
+const LITERAL_NULL string = "NULL"
+
+/* A node of an expression tree, one of the *Expr types below */
+type Expr interface {
+	expr()
+}
+
+/* An expression as written together with its tree
+ * Tree is nil when the parser couldn't read the expression, Text is always set
+ */
+type Expression struct {
+	Text string
+	Tree Expr `json:",omitempty"`
+}
+
+type LiteralExpr struct {
+	// one of the LITERAL_ constants
+	Kind string
+	// string contents without quotes, or the number as written
+	Value string `json:",omitempty"`
+}
+
+/* A column, pseudo column like SYSDATE or sequence reference like SEQ.NEXTVAL */
+type NameExpr struct {
+	Parts []NamePart
+}
+
+type FunctionExpr struct {
+	Name QualifiedName
+	Args []Expr `json:",omitempty"`
+	// true for COUNT(*)
+	Star bool `json:",omitempty"`
+}
+
+/* Arithmetic, ||, comparisons, LIKE, AND and OR */
+type BinaryExpr struct {
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+/* Sign or NOT */
+type UnaryExpr struct {
+	Op      string
+	Operand Expr
+}
+
+type ParenExpr struct {
+	Inner Expr
+}
+
+type WhenExpr struct {
+	When Expr
+	Then Expr
+}
+
+/* CASE WHEN ..., Operand is set for the simple form CASE x WHEN ... */
+type CaseExpr struct {
+	Operand Expr `json:",omitempty"`
+	Whens   []*WhenExpr
+	Else    Expr `json:",omitempty"`
+}
+
+type IsNullExpr struct {
+	Operand Expr
+	Not     bool `json:",omitempty"`
+}
+
+type InExpr struct {
+	Operand Expr
+	List    []Expr
+	Not     bool `json:",omitempty"`
+}
+
+type BetweenExpr struct {
+	Operand Expr
+	Low     Expr
+	High    Expr
+	Not     bool `json:",omitempty"`
+}
+
+func (*LiteralExpr) expr()  {}
+func (*NameExpr) expr()     {}
+func (*FunctionExpr) expr() {}
+func (*BinaryExpr) expr()   {}
+func (*UnaryExpr) expr()    {}
+func (*ParenExpr) expr()    {}
+func (*CaseExpr) expr()     {}
+func (*IsNullExpr) expr()   {}
+func (*InExpr) expr()       {}
+func (*BetweenExpr) expr()  {}
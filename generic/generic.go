@@ -1,20 +1,13 @@
 package generic
 
 import (
-	"errors"
 	"fmt"
 )
 
-type Grant struct {
-	Type  string
-	Where string
-	Who   string
-}
-
 func (d *TableDef) String() string {
 	result := ""
-	for cname, c := range d.Columns {
-		result = fmt.Sprintf("%s\n%q: %q", result, cname, c.Type)
+	for _, c := range d.Columns {
+		result = fmt.Sprintf("%s\n%q: %q", result, c.Name, c.Type)
 	}
 	return result
 }
@@ -37,17 +30,124 @@ type DbOrigin struct {
 }
 
 type TablesDef struct {
-	Origin DbOrigin
-	Tables map[string]*TableDef
+	Origin    DbOrigin
+	Tables    map[string]*TableDef
+	Sequences map[string]*SequenceDef
+	// grants and revokes in script order
+	Grants []*Grant
+}
+
+func NewTablesDef(origin DbOrigin) *TablesDef {
+	result := &TablesDef{
+		Origin:    origin,
+		Tables:    map[string]*TableDef{},
+		Sequences: map[string]*SequenceDef{},
+	}
+	return result
+}
+
+/* Adds parsed statements to the definitions in script order
+ * ALTER TABLE statements are applied to the table they change, so the result is the table after the whole script ran
+ * statements that can't be applied are reported but don't stop the rest, each one is a diagnostic of its own
+ */
+func (d *TablesDef) Add(stmts ...any) error {
+	errs := Diagnostics{}
+	fail := func(err error) {
+		errs = append(errs, ErrorDiagnostics(SEVERITY_ERROR, "", err)...)
+	}
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case TableDef:
+			d.Tables[s.Name.String()] = &s
+		case *TableDef:
+			d.Tables[s.Name.String()] = s
+		case AlterTable:
+			fail(d.alter(&s))
+		case *AlterTable:
+			fail(d.alter(s))
+		case SequenceDef:
+			d.Sequences[s.Name.String()] = &s
+		case *SequenceDef:
+			d.Sequences[s.Name.String()] = s
+		case Grant:
+			d.Grants = append(d.Grants, &s)
+		case *Grant:
+			d.Grants = append(d.Grants, s)
+		case Comment:
+			fail(d.comment(&s))
+		case *Comment:
+			fail(d.comment(s))
+		case IndexDef:
+			fail(d.index(&s))
+		case *IndexDef:
+			fail(d.index(s))
+		}
+	}
+	return errs.Err()
+}
+
+func (d *TablesDef) index(i *IndexDef) error {
+	t, ok := d.Tables[i.Table.String()]
+	if !ok {
+		return i.Position.Wrap(fmt.Errorf("create index %s: table %s is not defined", i.Name, i.Table))
+	}
+	t.Indexes = append(t.Indexes, i)
+	return nil
+}
+
+/* Attaches a comment to its table or column, an empty text removes it like in oracle */
+func (d *TablesDef) comment(c *Comment) error {
+	table := c.For
+	table.Column = NamePart{}
+	t, ok := d.Tables[table.String()]
+	if !ok {
+		return c.Position.Wrap(fmt.Errorf("comment on %s: table %s is not defined", c.For, table))
+	}
+	if c.On != COMMENT_ON_COLUMN {
+		t.Comment = c.Text
+		return nil
+	}
+	col := t.Columns.Get(c.For.Column.Normalized())
+	if col == nil {
+		return c.Position.Wrap(fmt.Errorf("comment on %s: no column %s on table %s", c.For, c.For.Column.Normalized(), table))
+	}
+	col.Comment = c.Text
+	return nil
+}
+
+func (d *TablesDef) alter(a *AlterTable) error {
+	t, ok := d.Tables[a.Table.String()]
+	if !ok {
+		return a.Position.Wrap(fmt.Errorf("alter table %s: table is not defined", a.Table))
+	}
+	errs := Diagnostics{}
+	for _, action := range a.Actions {
+		err := t.Alter(action)
+		if err != nil {
+			errs = append(errs, ErrorDiagnostics(SEVERITY_ERROR, "", action.Position.Errorf(err, "alter table %s: error while applying %s", a.Table, action.Kind))...)
+		}
+	}
+	return errs.Err()
 }
 
 type ColumnDef struct {
-	Name        string
-	Type        string
-	Default     string `json:",omitempty"`
-	Precision   int    `json:",omitempty"`
-	Scale       int    `json:",omitempty"`
-	VarCharSize int    `json:",omitempty"`
+	Name string
+	// 1 based position in the table as declared
+	Ordinal int
+	// zero when a MODIFY leaves the type as is
+	Type    DataType    `json:",omitzero"`
+	Default *Expression `json:",omitempty"`
+	// true when an enabled NOT NULL constraint is declared
+	NotNull bool `json:",omitempty"`
+	// inline constraints in declaration order, including NULL / NOT NULL
+	Constraints []*ConstraintDef `json:",omitempty"`
+	// nil unless the column is GENERATED AS IDENTITY
+	Identity *IdentityDef `json:",omitempty"`
+	// expression of a virtual column, nil for stored columns
+	Virtual *Expression `json:",omitempty"`
+	// COMMENT ON COLUMN text
+	Comment  string   `json:",omitempty"`
+	Position Position `json:",omitzero"`
 }
 
 type ColumnTypeArg struct {
@@ -55,19 +155,79 @@ type ColumnTypeArg struct {
 	Type   string
 }
 
-type ColumnsDef map[string]*ColumnDef
+/* Columns in declaration order */
+type ColumnsDef []*ColumnDef
+
+/* Finds a column by name, returns nil when there is none */
+func (cs ColumnsDef) Get(name string) *ColumnDef {
+	for _, c := range cs {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+/* Appends a column, numbering it after the existing ones */
+func (cs *ColumnsDef) Add(c *ColumnDef) {
+	c.Ordinal = len(*cs) + 1
+	*cs = append(*cs, c)
+}
 
 type TableDef struct {
-	Name            string
-	Columns         ColumnsDef
+	Name    QualifiedName
+	Columns ColumnsDef
+	// out of line constraints, inline ones stay with their column
+	Constraints []*ConstraintDef `json:",omitempty"`
+	// indexes created on the table by CREATE INDEX
+	Indexes []*IndexDef `json:",omitempty"`
+	// query of CREATE TABLE ... AS SELECT and its optional column aliases
 	SelectStatement string
+	SelectColumns   []string `json:",omitempty"`
+	// the query read into its parts, nil when it isn't a plain SELECT ... FROM
+	Select *SelectQuery `json:",omitempty"`
+	// clauses after the column list, nil when there are none
+	Physical *TablePhysicalDef `json:",omitempty"`
+	// GLOBAL or PRIVATE for temporary tables, empty for permanent ones
+	Temporary string `json:",omitempty"`
+	// DELETE ROWS, PRESERVE ROWS or PRESERVE DEFINITION as declared, oracle defaults to DELETE ROWS
+	OnCommit string `json:",omitempty"`
+	// COMMENT ON TABLE text
+	Comment  string   `json:",omitempty"`
+	Position Position `json:",omitzero"`
 }
 
+/* Wraps err in context, the message reads outermost first: "error while converting table T: no column C" */
 func Errorf(err error, format string, a ...any) error {
-	return errors.Join(err, fmt.Errorf(format, a...))
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, a...), err)
 }
 
+const COMMENT_ON_TABLE string = "TABLE"
+const COMMENT_ON_COLUMN string = "COLUMN"
+
 type Comment struct {
-	For  string
-	Text string
+	// one of the COMMENT_ON_ constants
+	On string
+	// For.Column is set for column comments
+	For      QualifiedName
+	Text     string
+	Position Position `json:",omitzero"`
+}
+
+/* A statement a tolerant parser couldn't read and stepped over, Line and Column are where it starts */
+type SkippedStatement struct {
+	Text   string
+	Line   int
+	Column int
+}
+
+/* A SQL*Plus @ or @@ line running another script, Line and Column are where it starts */
+type Include struct {
+	Path string
+	// @@, the path is relative to the including script instead of the directory the run started in
+	Relative bool `json:",omitempty"`
+	// arguments for the script's &1, &2... substitution variables
+	Args   []string `json:",omitempty"`
+	Line   int
+	Column int
 }
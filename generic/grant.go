@@ -0,0 +1,19 @@
+package generic
+
+/* An object privilege, Columns limits it to some columns, e.g. UPDATE ("A", "B") */
+type Privilege struct {
+	Name    string
+	Columns []string `json:",omitempty"`
+}
+
+/* GRANT or REVOKE of object privileges */
+type Grant struct {
+	// true for REVOKE
+	Revoke     bool `json:",omitempty"`
+	Privileges []Privilege
+	Where      QualifiedName
+	Who        []NamePart
+	// WITH GRANT OPTION, lets grantees pass the privileges on
+	WithGrantOption bool     `json:",omitempty"`
+	Position        Position `json:",omitzero"`
+}
@@ -0,0 +1,32 @@
+package generic
+
+type IndexColumnDef struct {
+	Name string `json:",omitempty"`
+	// expression of a function based index element, nil for plain columns
+	Expression *Expression `json:",omitempty"`
+	Descending bool        `json:",omitempty"`
+}
+
+type IndexDef struct {
+	Name    QualifiedName
+	Table   QualifiedName
+	Unique  bool `json:",omitempty"`
+	Bitmap  bool `json:",omitempty"`
+	Columns []*IndexColumnDef
+	// tablespace and physical attributes as declared
+	Tablespace string           `json:",omitempty"`
+	Options    []PhysicalOption `json:",omitempty"`
+	// clauses after the options that couldn't be read, as written
+	Unparsed string   `json:",omitempty"`
+	Position Position `json:",omitzero"`
+}
+
+/* True when any element is an expression instead of a plain column */
+func (d *IndexDef) FunctionBased() bool {
+	for _, c := range d.Columns {
+		if c.Expression != nil {
+			return true
+		}
+	}
+	return false
+}
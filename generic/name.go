@@ -0,0 +1,60 @@
+package generic
+
+import "strings"
+
+/* One part of a dotted name
+ * oracle folds unquoted names to upper case, quoted names keep their case
+ */
+type NamePart struct {
+	Name   string
+	Quoted bool `json:",omitempty"`
+}
+
+/* Name as oracle stores it in the dictionary */
+func (p NamePart) Normalized() string {
+	if p.Quoted {
+		return p.Name
+	}
+	return strings.ToUpper(p.Name)
+}
+
+/* A possibly schema qualified object name, e.g. "HR"."EMPLOYEES"
+ * Column is only set when the name points at a column, as in COMMENT ON COLUMN
+ */
+type QualifiedName struct {
+	Schema NamePart `json:",omitzero"`
+	Object NamePart
+	Column NamePart `json:",omitzero"`
+}
+
+/* Builds a name from its dotted parts, the last part is the object and the one before it the schema
+ * anything before the schema, like a database link owner, is dropped
+ */
+func NewQualifiedName(parts ...NamePart) QualifiedName {
+	result := QualifiedName{}
+	if len(parts) == 0 {
+		return result
+	}
+	result.Object = parts[len(parts)-1]
+	if len(parts) > 1 {
+		result.Schema = parts[len(parts)-2]
+	}
+	return result
+}
+
+/* Normalized dotted name, used as the key of definitions */
+func (n QualifiedName) String() string {
+	parts := []string{}
+	if n.Schema.Name != "" {
+		parts = append(parts, n.Schema.Normalized())
+	}
+	parts = append(parts, n.Object.Normalized())
+	if n.Column.Name != "" {
+		parts = append(parts, n.Column.Normalized())
+	}
+	return strings.Join(parts, ".")
+}
+
+func (n QualifiedName) IsZero() bool {
+	return n.Object.Name == ""
+}
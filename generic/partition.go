@@ -0,0 +1,34 @@
+package generic
+
+const PARTITION_RANGE string = "RANGE"
+const PARTITION_LIST string = "LIST"
+const PARTITION_HASH string = "HASH"
+const PARTITION_REFERENCE string = "REFERENCE"
+const PARTITION_SYSTEM string = "SYSTEM"
+
+/* A single partition of a partitioned table */
+type PartitionDef struct {
+	Name string `json:",omitempty"`
+	// upper bounds of a range partition, MAXVALUE included, or the values of a list partition, DEFAULT included
+	// kept as written, e.g. TO_DATE(' 2020-01-01 00:00:00', 'SYYYY-MM-DD HH24:MI:SS')
+	Values []string `json:",omitempty"`
+	// tablespace and other segment attributes of the partition
+	Physical *TablePhysicalDef `json:",omitempty"`
+}
+
+/* PARTITION BY clause of a table */
+type PartitioningDef struct {
+	// one of the PARTITION_ constants
+	Kind string
+	// partitioning columns, or the foreign key of reference partitioning
+	Columns []string `json:",omitempty"`
+	// INTERVAL expression of interval range partitioning, without the enclosing parens
+	Interval string `json:",omitempty"`
+	// PARTITIONS n of hash partitioning declared without a partition list
+	Count int `json:",omitempty"`
+	// STORE IN tablespaces of hash partitioning
+	StoreIn    []string        `json:",omitempty"`
+	Partitions []*PartitionDef `json:",omitempty"`
+	// SUBPARTITION BY clause as written
+	Subpartitioning string `json:",omitempty"`
+}
@@ -0,0 +1,63 @@
+package generic
+
+/* LOB (cols) STORE AS clause of a table */
+type LobStorageDef struct {
+	Columns []string
+	// SECUREFILE or BASICFILE, empty when not declared
+	Kind       string `json:",omitempty"`
+	Segment    string `json:",omitempty"`
+	Tablespace string `json:",omitempty"`
+	// remaining storage parameters as written
+	Parameters string `json:",omitempty"`
+}
+
+/* Physical, storage and other clauses following the column list of CREATE TABLE */
+type TablePhysicalDef struct {
+	Tablespace string `json:",omitempty"`
+	// HEAP, INDEX or EXTERNAL, empty when not declared
+	Organization string `json:",omitempty"`
+	// attributes other than the tablespace and organization, e.g. PCTFREE 10 or COMPRESS FOR OLTP
+	Options []PhysicalOption `json:",omitempty"`
+	Lobs    []*LobStorageDef `json:",omitempty"`
+	// PARTITION BY clause, nil for tables that aren't partitioned
+	Partitioning *PartitioningDef `json:",omitempty"`
+	// clauses that weren't understood, kept as written
+	Unparsed []string `json:",omitempty"`
+}
+
+/* Adds an option, TABLESPACE and ORGANIZATION get their own fields */
+func (p *TablePhysicalDef) Add(o PhysicalOption) {
+	switch o.Name {
+	case "TABLESPACE":
+		p.Tablespace = o.Value
+	case "ORGANIZATION":
+		p.Organization = o.Value
+	default:
+		p.Options = append(p.Options, o)
+	}
+}
+
+/* Finds an option by name, returns nil when it wasn't declared */
+func (p *TablePhysicalDef) Option(name string) *PhysicalOption {
+	for i := range p.Options {
+		if p.Options[i].Name == name {
+			return &p.Options[i]
+		}
+	}
+	return nil
+}
+
+/* Removes an option by name and returns it, nil when it wasn't declared */
+func (p *TablePhysicalDef) Take(name string) *PhysicalOption {
+	for i, o := range p.Options {
+		if o.Name == name {
+			p.Options = append(p.Options[:i], p.Options[i+1:]...)
+			return &o
+		}
+	}
+	return nil
+}
+
+func (p *TablePhysicalDef) IsEmpty() bool {
+	return p.Tablespace == "" && p.Organization == "" && len(p.Options) == 0 && len(p.Lobs) == 0 && p.Partitioning == nil && len(p.Unparsed) == 0
+}
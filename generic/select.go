@@ -0,0 +1,21 @@
+package generic
+
+/* A column of a parsed select list
+ * Star holds * or T.* instead of an expression
+ */
+type SelectItem struct {
+	Expression *Expression `json:",omitempty"`
+	Star       string      `json:",omitempty"`
+	Alias      NamePart    `json:",omitzero"`
+}
+
+/* A plain query read into its parts so its expressions can be translated
+ * From and Rest are kept as written, Rest is whatever follows the WHERE condition, e.g. GROUP BY
+ */
+type SelectQuery struct {
+	Distinct bool `json:",omitempty"`
+	Items    []*SelectItem
+	From     string
+	Where    *Expression `json:",omitempty"`
+	Rest     string      `json:",omitempty"`
+}
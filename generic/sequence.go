@@ -0,0 +1,65 @@
+package generic
+
+/* Options shared by CREATE SEQUENCE and identity columns
+ * numbers are kept as written since oracle allows 28 digits, empty when the source didn't declare them
+ */
+type SequenceOptions struct {
+	StartWith   string `json:",omitempty"`
+	IncrementBy string `json:",omitempty"`
+	MinValue    string `json:",omitempty"`
+	MaxValue    string `json:",omitempty"`
+	Cache       string `json:",omitempty"`
+	NoCache     bool   `json:",omitempty"`
+	Cycle       bool   `json:",omitempty"`
+	Order       bool   `json:",omitempty"`
+	// keywords without a field, e.g. KEEP, SCALE or SESSION
+	Extra []string `json:",omitempty"`
+}
+
+/* Applies a single option keyword, value is empty for flags */
+func (o *SequenceOptions) Set(keyword string, value string) {
+	switch keyword {
+	case "START WITH":
+		o.StartWith = value
+	case "INCREMENT BY":
+		o.IncrementBy = value
+	case "MINVALUE", "NOMINVALUE":
+		o.MinValue = value
+	case "MAXVALUE", "NOMAXVALUE":
+		o.MaxValue = value
+	case "CACHE":
+		o.Cache = value
+		o.NoCache = false
+	case "NOCACHE":
+		o.Cache = ""
+		o.NoCache = true
+	case "CYCLE", "NOCYCLE":
+		o.Cycle = keyword == "CYCLE"
+	case "ORDER", "NOORDER":
+		o.Order = keyword == "ORDER"
+	default:
+		o.Extra = append(o.Extra, keyword)
+	}
+}
+
+/* True when the sequence counts down, i.e. INCREMENT BY is negative */
+func (o *SequenceOptions) Descending() bool {
+	return len(o.IncrementBy) > 0 && o.IncrementBy[0] == '-'
+}
+
+type SequenceDef struct {
+	Name     QualifiedName
+	Options  SequenceOptions
+	Position Position `json:",omitzero"`
+}
+
+const IDENTITY_ALWAYS string = "ALWAYS"
+const IDENTITY_BY_DEFAULT string = "BY DEFAULT"
+const IDENTITY_BY_DEFAULT_ON_NULL string = "BY DEFAULT ON NULL"
+
+/* GENERATED ... AS IDENTITY clause of a column */
+type IdentityDef struct {
+	// one of the IDENTITY_ constants
+	Kind    string
+	Options SequenceOptions `json:",omitempty"`
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"path"
+	"strings"
+)
+
+/* Matches a slash separated path against a glob
+ * a pattern without a slash matches the file name in any directory, ** matches any number of directories
+ * case is ignored, scripts written on windows come as EMP.SQL as often as emp.sql
+ */
+func MatchGlob(pattern string, p string) bool {
+	pattern = strings.ToLower(pattern)
+	p = strings.ToLower(p)
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(p))
+		return ok
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(p, "/"))
+}
+
+func matchSegments(pattern []string, parts []string) bool {
+	if len(pattern) == 0 {
+		return len(parts) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(parts); i++ {
+			if matchSegments(pattern[1:], parts[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(parts) == 0 {
+		return false
+	}
+	ok, _ := path.Match(pattern[0], parts[0])
+	return ok && matchSegments(pattern[1:], parts[1:])
+}
+
+/* Returns true when p matches one of the include globs and none of the exclude globs */
+func Selected(p string, includes []string, excludes []string) bool {
+	p = strings.TrimPrefix(path.Clean(p), "./")
+	for _, exclude := range excludes {
+		if MatchGlob(exclude, p) {
+			return false
+		}
+	}
+	for _, include := range includes {
+		if MatchGlob(include, p) {
+			return true
+		}
+	}
+	return false
+}
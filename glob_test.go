@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*.sql", "emp.sql", true},
+		{"*.sql", "hr/tables/emp.sql", true},
+		{"*.sql", "emp.pks", false},
+		{"*.sql", "EMP.SQL", true},
+		{"*.SQL", "emp.sql", true},
+		{"emp_?.sql", "emp_1.sql", true},
+		{"emp_?.sql", "emp_10.sql", false},
+		{"tables/*.sql", "tables/emp.sql", true},
+		{"tables/*.sql", "hr/tables/emp.sql", false},
+		{"Tables/*.sql", "tables/EMP.sql", true},
+		{"**/*.sql", "emp.sql", true},
+		{"**/*.sql", "hr/tables/emp.sql", true},
+		{"hr/**/emp.sql", "hr/emp.sql", true},
+		{"hr/**/emp.sql", "hr/a/b/emp.sql", true},
+		{"hr/**/emp.sql", "sales/a/emp.sql", false},
+		{"hr/**", "hr/a/b/emp.sql", true},
+		{"hr/**", "HR/emp.sql", true},
+		{"hr/*", "hr/a/emp.sql", false},
+	}
+	for _, test := range tests {
+		if got := MatchGlob(test.pattern, test.path); got != test.want {
+			t.Errorf("MatchGlob(%q, %q) = %v, want %v", test.pattern, test.path, got, test.want)
+		}
+	}
+}
+
+func TestSelected(t *testing.T) {
+	tests := []struct {
+		path     string
+		includes []string
+		excludes []string
+		want     bool
+	}{
+		{"emp.sql", []string{"*.sql"}, nil, true},
+		{"./hr/emp.sql", []string{"hr/*.sql"}, nil, true},
+		{"emp.pkb", []string{"*.sql"}, nil, false},
+		{"emp.pkb", []string{"*.sql", "*.pkb"}, nil, true},
+		{"old/emp.sql", []string{"*.sql"}, []string{"old/**"}, false},
+		{"OLD/EMP.SQL", []string{"*.sql"}, []string{"old/**"}, false},
+		{"hr/emp.sql", []string{"*.sql"}, []string{"old/**"}, true},
+		{"emp.sql", nil, nil, false},
+	}
+	for _, test := range tests {
+		if got := Selected(test.path, test.includes, test.excludes); got != test.want {
+			t.Errorf("Selected(%q, %q, %q) = %v, want %v", test.path, test.includes, test.excludes, got, test.want)
+		}
+	}
+}
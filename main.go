@@ -2,77 +2,519 @@ package main
 
 import (
 	"encoding/json"
-	"io/fs"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"path"
 	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
+	"tsqlgrl/generic"
 	"tsqlgrl/oracle"
+	"tsqlgrl/tsql"
 )
 
-var Counter = 0
+/* Exit codes, documented in the usage and the readme */
+// everything ran without errors, warnings don't count
+const EXIT_OK int = 0
 
-func HandleFile(fpath string) error {
-	log.Println(fpath)
-	ext := strings.ToLower(path.Ext(fpath))
-	if ext != ".sql" {
-		return nil
+// errors were reported, e.g. syntax errors or tables that couldn't be converted
+const EXIT_ERRORS int = 1
+
+// unknown command, bad flags or an unreadable mapping config
+const EXIT_USAGE int = 2
+
+// output couldn't be written
+const EXIT_OUTPUT int = 3
+
+// write the converted t-sql
+const COMMAND_CONVERT string = "convert"
+
+// write the parsed statements as json
+const COMMAND_PARSE string = "parse"
+
+// only report diagnostics
+const COMMAND_CHECK string = "check"
+
+// write a summary of what the scripts contain and how the conversion went
+const COMMAND_REPORT string = "report"
+
+var Commands = []string{COMMAND_CONVERT, COMMAND_PARSE, COMMAND_CHECK, COMMAND_REPORT}
+
+// target dialects, sql server is the only one so far
+var Dialects = []string{"tsql"}
+
+var DiagnosticsFormats = []string{"text", "json", "sarif"}
+
+// one output file per input file, mirroring the input tree
+const LAYOUT_FILES string = "files"
+
+// one output file per object in the folders of an SSDT project, e.g. Tables/dbo.EMP.sql
+const LAYOUT_SSDT string = "ssdt"
+
+var Layouts = []string{LAYOUT_FILES, LAYOUT_SSDT}
+
+/* Verbosity levels of -v */
+// errors only
+const VERBOSITY_QUIET int = 0
+
+// errors and warnings
+const VERBOSITY_NORMAL int = 1
+
+// also logs every file and its parsed statements
+const VERBOSITY_DEBUG int = 2
+
+var Types = tsql.DefaultTypeMap()
+
+var SchemaMap map[string]string
+
+var PrincipalMap map[string]string
+
+var Filegroups map[string]string
+
+var TempTables = tsql.TEMP_SESSION
+
+var Command string
+
+// converted scripts and parsed json go here, stdout when empty
+var OutDir string
+
+// how OutDir is laid out, one of Layouts
+var Layout = LAYOUT_FILES
+
+// output files already written, two inputs must not write different contents to the same one
+var written = map[string]*writtenFile{}
+
+type writtenFile struct {
+	input   string
+	content string
+}
+
+var Includes []string
+
+var Excludes []string
+
+var Verbosity = VERBOSITY_NORMAL
+
+// everything the parser and the conversion reported, written once all files ran
+var Diagnostics generic.Diagnostics
+
+// how diagnostics are written: text, json or sarif
+var DiagnosticsFormat = "text"
+
+// skip statements the parser can't read instead of failing the file
+var Tolerant = false
+
+// files handled at the same time
+var Workers = runtime.NumCPU()
+
+// statements of every parsed file, written by the parse command
+var Parsed []*ParsedFile
+
+var Summary = NewSummary()
+
+// set when output couldn't be written
+var outputFailed = false
+
+/* Statements of a file as written by the parse command */
+type ParsedFile struct {
+	File       string
+	Statements []any
+}
+
+/* Counts written by the report command */
+type Report struct {
+	Files   int
+	Failed  int
+	Handled int
+	// statements by kind, see statementKind
+	Statements map[string]int
+	Tables     int
+	Warnings   int
+	Errors     int
+}
+
+// statement kinds in the order the report lists them
+var statementKinds = []string{"tables", "indexes", "sequences", "alter tables", "grants", "revokes", "comments", "skipped"}
+
+func NewSummary() *Report {
+	return &Report{Statements: map[string]int{}}
+}
+
+func statementKind(stmt any) string {
+	switch s := stmt.(type) {
+	case generic.TableDef, *generic.TableDef:
+		return "tables"
+	case generic.IndexDef, *generic.IndexDef:
+		return "indexes"
+	case generic.SequenceDef, *generic.SequenceDef:
+		return "sequences"
+	case generic.AlterTable, *generic.AlterTable:
+		return "alter tables"
+	case generic.Grant:
+		if s.Revoke {
+			return "revokes"
+		}
+		return "grants"
+	case *generic.Grant:
+		if s.Revoke {
+			return "revokes"
+		}
+		return "grants"
+	case generic.Comment, *generic.Comment:
+		return "comments"
+	case *generic.SkippedStatement:
+		return "skipped"
 	}
-	f, err := os.Open(fpath)
-	if err != nil {
-		return err
+	return ""
+}
+
+func (r *Report) String() string {
+	var sb strings.Builder
+	line := func(indent string, name string, n int) {
+		fmt.Fprintf(&sb, "%-18s %6d\n", indent+name, n)
 	}
-	defer f.Close()
+	line("", "files", r.Files)
+	line("  ", "failed", r.Failed)
+	line("  ", "handled", r.Handled)
+	total := 0
+	for _, n := range r.Statements {
+		total += n
+	}
+	line("", "statements", total)
+	for _, kind := range statementKinds {
+		if r.Statements[kind] > 0 {
+			line("  ", kind, r.Statements[kind])
+		}
+	}
+	line("", "converted tables", r.Tables)
+	line("", "warnings", r.Warnings)
+	line("", "errors", r.Errors)
+	return sb.String()
+}
 
-	res, err := oracle.ParseReader(fpath, f)
-	if err != nil {
+/* Writes the output of input fpath to rel inside OutDir, only called while merging results, or to stdout with a comment naming the input
+ * rel is slash separated, files written twice with the same content are fine, e.g. CREATE SCHEMA of an SSDT project
+ */
+func writeOutput(fpath string, rel string, content string) error {
+	if OutDir == "" {
+		if path.Ext(rel) == ".sql" {
+			fmt.Printf("-- %s\n", fpath)
+		}
+		fmt.Println(content)
+		return nil
+	}
+	target := filepath.Join(OutDir, filepath.FromSlash(rel))
+	if other, ok := written[target]; ok {
+		if other.content == content {
+			return nil
+		}
+		return fmt.Errorf("%s would overwrite the output of %s", target, other.input)
+	}
+	written[target] = &writtenFile{input: fpath, content: content}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		outputFailed = true
 		return err
 	}
-	bs, err := json.MarshalIndent(res, "", " ")
-	if err != nil {
+	if err := os.WriteFile(target, []byte(content), 0o644); err != nil {
+		outputFailed = true
 		return err
 	}
-	str := string(bs)
-	log.Println(str)
-	Counter++
-	// log.Println(res)
-
 	return nil
 }
 
-func HandlePath(p string) error {
-	fi, err := os.Stat(p)
+/* Output path of an input, its path below the directory it was found in with ext instead of its extension */
+func outputPath(rel string, ext string) string {
+	return strings.TrimSuffix(rel, path.Ext(rel)) + ext
+}
+
+/* Runs Command over a file
+ * it runs on a worker, so everything it produces goes into the result instead of the shared state
+ */
+func HandleFile(job *Job) *FileResult {
+	fpath := job.File
+	result := NewFileResult(fpath)
+	result.debug(fpath)
+	reader := &oracle.Script{Tolerant: Tolerant}
+	defer result.readFrom(reader)
+	stmts, err := reader.Read(fpath)
+	result.Diagnostics = append(result.Diagnostics, reader.Diagnostics...)
+	if err == oracle.ErrScriptFailed {
+		result.Failed = true
+		return result
+	}
 	if err != nil {
+		result.fail(err)
+		return result
+	}
+	for _, stmt := range stmts {
+		if kind := statementKind(stmt); kind != "" {
+			result.Statements[kind]++
+		}
+	}
+	if Verbosity >= VERBOSITY_DEBUG || Command == COMMAND_PARSE {
+		bs, err := json.MarshalIndent(stmts, "", " ")
+		if err != nil {
+			result.fail(err)
+			return result
+		}
+		result.debug(string(bs))
+		if Command == COMMAND_PARSE {
+			if OutDir != "" {
+				result.output(outputPath(job.Rel, ".json"), string(bs))
+			} else {
+				result.Parsed = &ParsedFile{File: fpath, Statements: stmts}
+			}
+			return result
+		}
+	}
+
+	tables := generic.NewTablesDef(oracle.Origin)
+	err = tables.Add(stmts...)
+	result.Diagnostics = append(result.Diagnostics, generic.ErrorDiagnostics(generic.SEVERITY_ERROR, fpath, err)...)
+	serializer := tsql.NewSerializer()
+	serializer.Types = Types
+	serializer.SchemaMap = SchemaMap
+	serializer.PrincipalMap = PrincipalMap
+	serializer.Filegroups = Filegroups
+	serializer.TempTables = TempTables
+	if Layout == LAYOUT_SSDT {
+		objects := serializer.Objects(tables)
+		result.converted(serializer, tables)
+		for _, object := range objects {
+			result.debug(object.Path() + "\n" + object.Script)
+			result.output(object.Path(), object.Script)
+		}
+		return result
+	}
+	script := serializer.Tables(tables)
+	result.converted(serializer, tables)
+	result.debug(script)
+	result.output(outputPath(job.Rel, ".sql"), script)
+	return result
+}
+
+/* Adds a file's result to the totals and writes its output
+ * results are merged one at a time in job order
+ */
+func MergeResult(r *FileResult) {
+	for _, line := range r.Log {
+		log.Println(line)
+	}
+	Summary.Files++
+	if r.Failed {
+		Summary.Failed++
+	} else {
+		Summary.Handled++
+	}
+	for kind, n := range r.Statements {
+		Summary.Statements[kind] += n
+	}
+	Summary.Tables += r.Tables
+	Diagnostics = append(Diagnostics, r.Diagnostics...)
+	if r.Parsed != nil {
+		Parsed = append(Parsed, r.Parsed)
+	}
+	for _, o := range r.Outputs {
+		err := writeOutput(r.File, o.Rel, o.Content)
+		Diagnostics = append(Diagnostics, generic.ErrorDiagnostics(generic.SEVERITY_ERROR, r.File, err)...)
+	}
+}
+
+/* Diagnostics shown at the current verbosity, quiet leaves only errors */
+func shownDiagnostics() generic.Diagnostics {
+	if Verbosity > VERBOSITY_QUIET {
+		return Diagnostics
+	}
+	results := generic.Diagnostics{}
+	for _, d := range Diagnostics {
+		if d.Severity == generic.SEVERITY_ERROR {
+			results = append(results, d)
+		}
+	}
+	return results
+}
+
+/* Writes the diagnostics in DiagnosticsFormat
+ * check has no other output so they go to stdout, the other commands keep stdout for their results
+ */
+func WriteDiagnostics() error {
+	out := os.Stderr
+	if Command == COMMAND_CHECK {
+		out = os.Stdout
+	}
+	diagnostics := shownDiagnostics()
+	switch DiagnosticsFormat {
+	case "json":
+		bs, err := diagnostics.JSON()
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(bs))
+		return err
+	case "sarif":
+		bs, err := diagnostics.SARIF("sqlgrl")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(bs))
 		return err
 	}
+	_, err := fmt.Fprint(out, diagnostics.Format())
+	return err
+}
 
-	if fi.IsDir() {
+const usage = `usage: sqlgrl <command> [flags] <file or directory>...
 
-		return filepath.WalkDir(p, func(filePath string, d fs.DirEntry, err error) error {
-			if d.IsDir() {
-				return nil
-			}
+commands:
+  convert   convert oracle DDL scripts to t-sql
+  parse     write the parsed statements as json
+  check     only report diagnostics, exits with 1 when there are errors
+  report    write a summary of the statements and how their conversion went
 
-			return HandleFile(filePath)
-		})
-	} else {
-		return HandleFile(p)
+exit codes:
+  0  no errors, warnings don't count
+  1  errors were reported
+  2  unknown command, bad flags or an unreadable mapping config
+  3  output couldn't be written
+
+flags:
+`
+
+/* Flag value collecting globs, repeated flags and comma separated lists add up */
+type globList []string
+
+func (l *globList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *globList) Set(value string) error {
+	for _, glob := range strings.Split(value, ",") {
+		if glob = strings.TrimSpace(glob); glob != "" {
+			*l = append(*l, glob)
+		}
 	}
+	return nil
 }
 
-func main() {
-	argsLen := len(os.Args)
-	if argsLen < 2 {
-		panic("not enough args, expected first arg to be a file or directory")
+/* Reads the command and its flags, returns the paths to handle */
+func parseArgs(args []string) ([]string, error) {
+	flags := flag.NewFlagSet("sqlgrl", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
 	}
 
-	openPath := os.Args[1]
+	var includes, excludes globList
+	flags.StringVar(&OutDir, "out", "", "write converted scripts (convert) or json (parse) to this directory instead of stdout, mirroring the input tree")
+	flags.StringVar(&Layout, "layout", LAYOUT_FILES, "output layout: files for one file per input, ssdt for one file per object in SSDT project folders")
+	dialect := flags.String("dialect", Dialects[0], "target dialect: "+strings.Join(Dialects, ", "))
+	config := flags.String("config", "", "json mapping config for types, schemas, principals, filegroups and temporary tables")
+	flags.Var(&includes, "include", "glob of files to handle inside directories, repeatable (default *.sql)")
+	flags.Var(&excludes, "exclude", "glob of files to skip inside directories, repeatable")
+	flags.IntVar(&Verbosity, "v", VERBOSITY_NORMAL, "verbosity: 0 errors only, 1 warnings too, 2 also logs every file and its statements")
+	flags.StringVar(&DiagnosticsFormat, "diagnostics", "text", "diagnostics format: "+strings.Join(DiagnosticsFormats, ", "))
+	flags.BoolVar(&Tolerant, "tolerant", false, "skip statements the parser can't read instead of failing the file")
+	flags.IntVar(&Workers, "j", runtime.NumCPU(), "number of files handled at the same time")
+
+	if len(args) == 0 || !slices.Contains(Commands, args[0]) {
+		flags.Usage()
+		if len(args) == 0 {
+			return nil, errors.New("missing command")
+		}
+		return nil, fmt.Errorf("unknown command %q", args[0])
+	}
+	Command = args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		return nil, err
+	}
 
-	err := HandlePath(openPath)
+	if !slices.Contains(Dialects, *dialect) {
+		return nil, fmt.Errorf("unknown dialect %q, expected one of %s", *dialect, strings.Join(Dialects, ", "))
+	}
+	if Workers < 1 {
+		return nil, fmt.Errorf("-j needs at least 1 worker, got %d", Workers)
+	}
+	if !slices.Contains(Layouts, Layout) {
+		return nil, fmt.Errorf("unknown layout %q, expected one of %s", Layout, strings.Join(Layouts, ", "))
+	}
+	if Layout == LAYOUT_SSDT && Command == COMMAND_CONVERT && OutDir == "" {
+		return nil, errors.New("the ssdt layout writes many files and needs -out")
+	}
+	if !slices.Contains(DiagnosticsFormats, DiagnosticsFormat) {
+		return nil, fmt.Errorf("unknown diagnostics format %q, expected one of %s", DiagnosticsFormat, strings.Join(DiagnosticsFormats, ", "))
+	}
+	if *config != "" {
+		if err := LoadConfig(*config); err != nil {
+			return nil, err
+		}
+	}
+	Includes = includes
+	if len(Includes) == 0 {
+		Includes = []string{"*.sql"}
+	}
+	Excludes = excludes
+	if flags.NArg() == 0 {
+		return nil, errors.New("expected at least one file or directory")
+	}
+	return flags.Args(), nil
+}
+
+func run() int {
+	paths, err := parseArgs(os.Args[1:])
 	if err != nil {
-		panic(err)
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "sqlgrl:", err)
+		}
+		return EXIT_USAGE
+	}
+
+	jobs, diagnostics := CollectJobs(paths)
+	Diagnostics = append(Diagnostics, diagnostics...)
+	// results are merged once every file ran, only then it is known which were included by others
+	results := []*FileResult{}
+	RunJobs(jobs, Workers, func(r *FileResult) { results = append(results, r) })
+	results, notes := DropIncluded(results)
+	for _, r := range results {
+		MergeResult(r)
+	}
+	Diagnostics = append(Diagnostics, notes...)
+	Summary.Warnings = Diagnostics.Count(generic.SEVERITY_WARNING)
+	Summary.Errors = Diagnostics.Count(generic.SEVERITY_ERROR)
+
+	switch Command {
+	case COMMAND_PARSE:
+		if OutDir == "" {
+			bs, err := json.MarshalIndent(Parsed, "", " ")
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "sqlgrl:", err)
+				return EXIT_OUTPUT
+			}
+			fmt.Println(string(bs))
+		}
+	case COMMAND_REPORT:
+		fmt.Print(Summary)
+	}
+	if err := WriteDiagnostics(); err != nil {
+		fmt.Fprintln(os.Stderr, "sqlgrl:", err)
+		return EXIT_OUTPUT
 	}
 
-	log.Println("done, parsed", Counter, "files")
+	if Verbosity >= VERBOSITY_DEBUG {
+		log.Println("done, handled", Summary.Handled, "files")
+	}
+	switch {
+	case outputFailed:
+		return EXIT_OUTPUT
+	case Diagnostics.HasErrors():
+		return EXIT_ERRORS
+	}
+	return EXIT_OK
+}
+
+func main() {
+	os.Exit(run())
 }
@@ -0,0 +1,149 @@
+package oracle
+
+import (
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* Fills a column's size arguments according to its type
+ * shared by column definitions and MODIFY column clauses
+ */
+func applyTypeArgs(result *generic.DataType, args any) {
+	if args == nil {
+		return
+	}
+
+	items := args.([]generic.ColumnTypeArg)
+	itemslen := len(items)
+
+	if itemslen > 0 {
+		switch result.Name {
+		case "NUMBER", "NUMERIC", "NUMERICAL", "DECIMAL", "FLOAT":
+			result.Precision = items[0].Number
+			if itemslen > 1 {
+				result.Scale = items[1].Number
+				result.Scaled = true
+			}
+		case "VARCHAR", "VARCHAR2", "CHAR", "NCHAR", "NVARCHAR2":
+			result.Length = items[0].Number
+			result.LengthSemantics = items[0].Type
+		case "RAW", "UROWID":
+			result.Length = items[0].Number
+		}
+	}
+}
+
+// global store key of the script being parsed, positions name it as their File
+const FILE_KEY string = "file"
+
+/* Where the current match starts */
+func sourcePosition(c *current) generic.Position {
+	file, _ := c.globalStore[FILE_KEY].(string)
+	return generic.Position{File: file, Line: c.pos.line, Column: c.pos.col}
+}
+
+/* Collects (WhiteSpace SequenceOption) matches into sequence options
+ * shared by CREATE SEQUENCE and identity columns
+ */
+func sequenceOptions(opts any) generic.SequenceOptions {
+	result := generic.SequenceOptions{}
+	for _, opt := range opts.([]any) {
+		o := opt.([]any)[1].([]string)
+		result.Set(o[0], o[1])
+	}
+	return result
+}
+
+/* Text of a clause the grammar doesn't know, kept so it can be reported */
+type unparsed string
+
+/* Collects physical clause matches into a table's physical options
+ * index is the position of the clause in each match, consecutive unknown tokens are joined into one clause
+ * returns nil when there are no clauses
+ */
+func tablePhysical(items any, index int) *generic.TablePhysicalDef {
+	result := &generic.TablePhysicalDef{}
+	run := []string{}
+	flush := func() {
+		if len(run) > 0 {
+			result.Unparsed = append(result.Unparsed, strings.Join(run, " "))
+			run = nil
+		}
+	}
+	for _, item := range items.([]any) {
+		switch v := item.([]any)[index].(type) {
+		case unparsed:
+			run = append(run, string(v))
+			continue
+		case generic.PhysicalOption:
+			result.Add(v)
+		case *generic.LobStorageDef:
+			result.Lobs = append(result.Lobs, v)
+		case *generic.PartitioningDef:
+			result.Partitioning = v
+		}
+		flush()
+	}
+	flush()
+	if result.IsEmpty() {
+		return nil
+	}
+	return result
+}
+
+/* Splits a comma separated list on its top level commas
+ * commas in quotes or nested parens don't split, items are trimmed
+ */
+func splitList(str string) []string {
+	results := []string{}
+	depth := 0
+	quote := rune(0)
+	start := 0
+	for i, r := range str {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			results = append(results, strings.TrimSpace(str[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(str[start:]); last != "" || len(results) > 0 {
+		results = append(results, last)
+	}
+	return results
+}
+
+/* Folds a first operand and partial binary expressions, whose Left is still nil, into a left associative tree */
+func binaryChain(first any, rest any) generic.Expr {
+	result := first.(generic.Expr)
+	for _, r := range rest.([]any) {
+		b := r.(*generic.BinaryExpr)
+		b.Left = result
+		result = b
+	}
+	return result
+}
+
+/* Fills in the left side of a predicate tail like IS NULL or IN (...) */
+func predicate(left generic.Expr, tail generic.Expr) generic.Expr {
+	switch t := tail.(type) {
+	case *generic.BinaryExpr:
+		t.Left = left
+	case *generic.IsNullExpr:
+		t.Operand = left
+	case *generic.InExpr:
+		t.Operand = left
+	case *generic.BetweenExpr:
+		t.Operand = left
+	}
+	return tail
+}
@@ -0,0 +1,72 @@
+package oracle
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+	"unicode"
+	"unicode/utf8"
+)
+
+// starts of WhiteSpace, accepted almost everywhere so they only clutter the expected list
+var whitespaceExpected = []string{`"--"`, `"/*"`, `[ \t]`, `[ \r\n]`}
+
+// starts of SqlPlusCommand, a script going wrong where a statement starts hardly meant one of them
+var sqlPlusExpected = []string{`"SET"i`, `"PROMPT"i`, `"REMARK"i`, `"REM"i`, `"SPOOL"i`, `"WHENEVER"i`, `"EXIT"i`, `"QUIT"i`, `"DEFINE"i`, `"UNDEFINE"i`}
+
+/* Turns the error returned by Parse into diagnostics pointing into source
+ * pigeon reports the farthest position it got to together with everything it would have accepted there
+ */
+func Diagnostics(filename string, source []byte, err error) generic.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	list, ok := err.(errList)
+	if !ok {
+		list = errList{err}
+	}
+	results := generic.Diagnostics{}
+	for _, e := range list {
+		pe, ok := e.(*parserError)
+		if !ok {
+			results = append(results, generic.ErrorDiagnostics(generic.SEVERITY_ERROR, filename, e)...)
+			continue
+		}
+		message := pe.Inner.Error()
+		if len(pe.expected) > 0 {
+			message = "unexpected " + foundAt(source, pe.pos.offset)
+		}
+		d := generic.NewDiagnostic(generic.SEVERITY_ERROR, filename, source, pe.pos.line, pe.pos.col, message)
+		for _, expected := range pe.expected {
+			if !slices.Contains(whitespaceExpected, expected) && !slices.Contains(sqlPlusExpected, expected) {
+				d.Expected = append(d.Expected, expected)
+			}
+		}
+		results = append(results, d)
+	}
+	return results
+}
+
+/* Describes the word or character at offset for a syntax error */
+func foundAt(source []byte, offset int) string {
+	if offset >= len(source) {
+		return "end of file"
+	}
+	rest := string(source[offset:])
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$' || r == '#'
+	}
+	end := strings.IndexFunc(rest, func(r rune) bool { return !isWord(r) })
+	switch {
+	case end < 0:
+		end = len(rest)
+	case end == 0:
+		_, end = utf8.DecodeRuneInString(rest)
+	}
+	word := rest[:end]
+	if strings.TrimSpace(word) == "" {
+		return "whitespace"
+	}
+	return fmt.Sprintf("%q", word)
+}
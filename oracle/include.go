@@ -0,0 +1,137 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+// sql*plus adds .sql to script names without an extension
+const SCRIPT_EXTENSION string = ".sql"
+
+// returned by Script.Read when the entry script couldn't be parsed, its Diagnostics tell why
+var ErrScriptFailed = errors.New("no statements could be read")
+
+/* Reads an entry script and the scripts it runs with @ and @@ as a single logical script
+ * statements of an included script take the place of its include line, diagnostics keep the file and line they're in
+ * and so do the positions of statements, later diagnostics about them point into the included script
+ * @ paths are resolved against Root like running sql*plus from there, @@ paths against the including script
+ */
+type Script struct {
+	// directory @ paths are relative to, the entry script's directory when empty
+	Root string
+	// parse with ParseTolerant instead of Parse
+	Tolerant    bool
+	Diagnostics generic.Diagnostics
+	// source of every script read by path, to quote lines in later diagnostics
+	Sources map[string][]byte
+}
+
+/* Parses the script at fpath with everything it includes
+ * only the entry script failing is an error, problems with included scripts are reported at their include line
+ */
+func (s *Script) Read(fpath string) ([]any, error) {
+	if s.Root == "" {
+		s.Root = filepath.Dir(fpath)
+	}
+	stmts := []any{}
+	if err := s.read(fpath, nil, &stmts); err != nil {
+		return nil, err
+	}
+	return stmts, nil
+}
+
+func (s *Script) read(fpath string, stack []string, results *[]any) error {
+	source, err := os.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	if s.Sources == nil {
+		s.Sources = map[string][]byte{}
+	}
+	s.Sources[fpath] = source
+	var stmts []any
+	if s.Tolerant {
+		var diagnostics generic.Diagnostics
+		stmts, diagnostics = ParseTolerant(fpath, source, GlobalStore(FILE_KEY, fpath))
+		s.Diagnostics = append(s.Diagnostics, diagnostics...)
+		if stmts == nil {
+			return ErrScriptFailed
+		}
+	} else {
+		res, err := Parse(fpath, source, GlobalStore(FILE_KEY, fpath))
+		if err != nil {
+			s.Diagnostics = append(s.Diagnostics, Diagnostics(fpath, source, err)...)
+			return ErrScriptFailed
+		}
+		stmts, _ = res.([]any)
+	}
+
+	abs, err := filepath.Abs(fpath)
+	if err != nil {
+		return err
+	}
+	stack = append(stack, abs)
+	for _, stmt := range stmts {
+		include, ok := stmt.(*generic.Include)
+		if !ok {
+			*results = append(*results, stmt)
+			continue
+		}
+		report := func(severity string, message string) {
+			s.Diagnostics = append(s.Diagnostics, generic.NewDiagnostic(severity, fpath, source, include.Line, include.Column, message))
+		}
+
+		target := s.resolve(fpath, include)
+		targetAbs, err := filepath.Abs(target)
+		if err != nil {
+			report(generic.SEVERITY_ERROR, err.Error())
+			continue
+		}
+		if i := slices.Index(stack, targetAbs); i >= 0 {
+			cycle := slices.Clone(stack[i:])
+			for j := range cycle {
+				cycle[j] = filepath.Base(cycle[j])
+			}
+			report(generic.SEVERITY_ERROR, fmt.Sprintf("include cycle %s -> %s, the include was skipped", strings.Join(cycle, " -> "), filepath.Base(target)))
+			continue
+		}
+		if len(include.Args) > 0 {
+			report(generic.SEVERITY_WARNING, fmt.Sprintf("arguments of %s are ignored, &1 and the like aren't substituted", target))
+		}
+
+		err = s.read(target, stack, results)
+		switch {
+		case err == ErrScriptFailed:
+			report(generic.SEVERITY_NOTE, fmt.Sprintf("in %s included here", target))
+		case err != nil:
+			var pathErr *fs.PathError
+			if errors.As(err, &pathErr) {
+				err = pathErr.Err
+			}
+			report(generic.SEVERITY_ERROR, fmt.Sprintf("can't include %s: %v", target, err))
+		}
+	}
+	return nil
+}
+
+/* The file an include refers to, as sql*plus would look for it */
+func (s *Script) resolve(including string, include *generic.Include) string {
+	// scripts written on windows separate directories with \
+	p := filepath.FromSlash(strings.ReplaceAll(include.Path, `\`, "/"))
+	if filepath.Ext(p) == "" {
+		p += SCRIPT_EXTENSION
+	}
+	if filepath.IsAbs(p) {
+		return p
+	}
+	if include.Relative {
+		return filepath.Join(filepath.Dir(including), p)
+	}
+	return filepath.Join(s.Root, p)
+}
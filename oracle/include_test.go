@@ -0,0 +1,150 @@
+package oracle
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"tsqlgrl/generic"
+)
+
+func TestScriptRead(t *testing.T) {
+	tests := []struct {
+		name string
+		// file contents by slash separated path, main.sql is the entry script
+		files map[string]string
+		// the tables read, in statement order
+		tables []string
+		// parts of the diagnostics reported, in order
+		diagnostics []string
+	}{
+		{
+			name: "no includes",
+			files: map[string]string{
+				"main.sql": "CREATE TABLE \"A1\" (\"ID\" NUMBER);\n",
+			},
+			tables: []string{"A1"},
+		},
+		{
+			name: "include in place",
+			files: map[string]string{
+				"main.sql":     "CREATE TABLE \"A1\" (\"ID\" NUMBER);\n@tables/b.sql\nCREATE TABLE \"C1\" (\"ID\" NUMBER);\n",
+				"tables/b.sql": "CREATE TABLE \"B1\" (\"ID\" NUMBER);\n",
+			},
+			tables: []string{"A1", "B1", "C1"},
+		},
+		{
+			name: "extension added",
+			files: map[string]string{
+				"main.sql": "@b\n",
+				"b.sql":    "CREATE TABLE \"B1\" (\"ID\" NUMBER);\n",
+			},
+			tables: []string{"B1"},
+		},
+		{
+			name: "at resolves against the root, at at against the including script",
+			files: map[string]string{
+				"main.sql":  "@sub/b.sql\n",
+				"sub/b.sql": "@c.sql\n@@c.sql\n",
+				"c.sql":     "CREATE TABLE \"ROOT_C\" (\"ID\" NUMBER);\n",
+				"sub/c.sql": "CREATE TABLE \"SUB_C\" (\"ID\" NUMBER);\n",
+			},
+			tables: []string{"ROOT_C", "SUB_C"},
+		},
+		{
+			name: "windows separators",
+			files: map[string]string{
+				"main.sql":  "@sub\\b.sql\n",
+				"sub/b.sql": "CREATE TABLE \"B1\" (\"ID\" NUMBER);\n",
+			},
+			tables: []string{"B1"},
+		},
+		{
+			name: "cycle",
+			files: map[string]string{
+				"main.sql": "CREATE TABLE \"A1\" (\"ID\" NUMBER);\n@b.sql\n",
+				"b.sql":    "CREATE TABLE \"B1\" (\"ID\" NUMBER);\n@main.sql\n",
+			},
+			tables:      []string{"A1", "B1"},
+			diagnostics: []string{"include cycle main.sql -> b.sql -> main.sql"},
+		},
+		{
+			name: "missing file",
+			files: map[string]string{
+				"main.sql": "@missing.sql\nCREATE TABLE \"A1\" (\"ID\" NUMBER);\n",
+			},
+			tables:      []string{"A1"},
+			diagnostics: []string{"can't include"},
+		},
+		{
+			name: "included script fails",
+			files: map[string]string{
+				"main.sql": "@b.sql\nCREATE TABLE \"A1\" (\"ID\" NUMBER);\n",
+				"b.sql":    "CREATE TABLE (;\n",
+			},
+			tables:      []string{"A1"},
+			diagnostics: []string{"", "included here"},
+		},
+		{
+			name: "arguments",
+			files: map[string]string{
+				"main.sql": "@b.sql HR \"two words\"\n",
+				"b.sql":    "CREATE TABLE \"B1\" (\"ID\" NUMBER);\n",
+			},
+			tables:      []string{"B1"},
+			diagnostics: []string{"arguments of"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range test.files {
+				fpath := filepath.Join(dir, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(fpath), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(fpath, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			script := &Script{}
+			stmts, err := script.Read(filepath.Join(dir, "main.sql"))
+			if err != nil {
+				t.Fatalf("%v: %v", err, script.Diagnostics)
+			}
+			tables := []string{}
+			for _, stmt := range stmts {
+				if table, ok := stmt.(generic.TableDef); ok {
+					tables = append(tables, table.Name.Object.Normalized())
+				}
+			}
+			if !slices.Equal(tables, test.tables) {
+				t.Errorf("got tables %q, want %q", tables, test.tables)
+			}
+			if len(script.Diagnostics) != len(test.diagnostics) {
+				t.Fatalf("got diagnostics %v, want %d", script.Diagnostics, len(test.diagnostics))
+			}
+			for i, d := range script.Diagnostics {
+				if !strings.Contains(d.Message, test.diagnostics[i]) {
+					t.Errorf("got diagnostic %q, want one containing %q", d.Message, test.diagnostics[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScriptReadFails(t *testing.T) {
+	dir := t.TempDir()
+	fpath := filepath.Join(dir, "main.sql")
+	if err := os.WriteFile(fpath, []byte("CREATE TABLE (;\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	script := &Script{}
+	if _, err := script.Read(fpath); err != ErrScriptFailed {
+		t.Errorf("got %v, want ErrScriptFailed", err)
+	}
+	if len(script.Diagnostics) == 0 {
+		t.Error("no diagnostics for the failed script")
+	}
+}
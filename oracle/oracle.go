@@ -0,0 +1,10 @@
+package oracle
+
+import "tsqlgrl/generic"
+
+var Origin = generic.DbOrigin{
+	Vendor:      generic.EngineVendorInfo{Name: "Oracle"},
+	Engine:      generic.EngineInfo{Name: "Oracle Database"},
+	Dialect:     "oracle",
+	Description: "oracle sql DDL export",
+}
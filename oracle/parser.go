@@ -75,383 +75,718 @@ var g = &grammar{
 				alternatives: []any{
 					&ruleRefExpr{
 						pos:  position{line: 23, col: 14, offset: 442},
+						name: "KnownStatement",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 23, col: 31, offset: 459},
+						name: "SkippedStatement",
+					},
+				},
+			},
+		},
+		{
+			name: "KnownStatement",
+			pos:  position{line: 25, col: 1, offset: 479},
+			expr: &choiceExpr{
+				pos: position{line: 25, col: 19, offset: 497},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 25, col: 19, offset: 497},
 						name: "CreateTable",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 23, col: 28, offset: 456},
+						pos:  position{line: 25, col: 33, offset: 511},
+						name: "CreateIndex",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 25, col: 47, offset: 525},
+						name: "CreateSequence",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 25, col: 64, offset: 542},
+						name: "AlterTable",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 25, col: 77, offset: 555},
 						name: "Grant",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 23, col: 36, offset: 464},
+						pos:  position{line: 25, col: 85, offset: 563},
+						name: "Revoke",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 25, col: 94, offset: 572},
 						name: "Comment",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 23, col: 46, offset: 474},
+						pos:  position{line: 25, col: 104, offset: 582},
 						name: "Include",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 25, col: 114, offset: 592},
+						name: "SqlPlusSlash",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 25, col: 129, offset: 607},
+						name: "SqlPlusCommand",
+					},
 				},
 			},
 		},
 		{
-			name: "CreateTable",
-			pos:  position{line: 26, col: 1, offset: 487},
+			name: "SqlPlusSlash",
+			pos:  position{line: 28, col: 1, offset: 718},
 			expr: &actionExpr{
-				pos: position{line: 26, col: 16, offset: 502},
-				run: (*parser).callonCreateTable1,
+				pos: position{line: 28, col: 17, offset: 734},
+				run: (*parser).callonSqlPlusSlash1,
 				expr: &seqExpr{
-					pos: position{line: 26, col: 16, offset: 502},
+					pos: position{line: 28, col: 17, offset: 734},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 26, col: 16, offset: 502},
-							val:        "CREATE",
-							ignoreCase: false,
-							want:       "\"CREATE\"",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 26, col: 25, offset: 511},
-							expr: &ruleRefExpr{
-								pos:  position{line: 26, col: 25, offset: 511},
-								name: "WhiteSpace",
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 26, col: 37, offset: 523},
-							expr: &litMatcher{
-								pos:        position{line: 26, col: 37, offset: 523},
-								val:        "GLOBAL",
-								ignoreCase: false,
-								want:       "\"GLOBAL\"",
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 26, col: 47, offset: 533},
-							expr: &ruleRefExpr{
-								pos:  position{line: 26, col: 47, offset: 533},
-								name: "WhiteSpace",
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 26, col: 59, offset: 545},
-							expr: &litMatcher{
-								pos:        position{line: 26, col: 59, offset: 545},
-								val:        "TEMPORARY",
-								ignoreCase: false,
-								want:       "\"TEMPORARY\"",
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 26, col: 72, offset: 558},
-							expr: &ruleRefExpr{
-								pos:  position{line: 26, col: 72, offset: 558},
-								name: "WhiteSpace",
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 26, col: 84, offset: 570},
-							val:        "TABLE",
+							pos:        position{line: 28, col: 17, offset: 734},
+							val:        "/",
 							ignoreCase: false,
-							want:       "\"TABLE\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 26, col: 92, offset: 578},
-							name: "WhiteSpace",
-						},
-						&labeledExpr{
-							pos:   position{line: 26, col: 103, offset: 589},
-							label: "name",
-							expr: &ruleRefExpr{
-								pos:  position{line: 26, col: 108, offset: 594},
-								name: "TableName",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 26, col: 118, offset: 604},
-							name: "WhiteSpace",
+							want:       "\"/\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 26, col: 129, offset: 615},
-							label: "body",
-							expr: &ruleRefExpr{
-								pos:  position{line: 26, col: 134, offset: 620},
-								name: "TableBody",
+						&andExpr{
+							pos: position{line: 28, col: 21, offset: 738},
+							expr: &seqExpr{
+								pos: position{line: 28, col: 23, offset: 740},
+								exprs: []any{
+									&zeroOrMoreExpr{
+										pos: position{line: 28, col: 23, offset: 740},
+										expr: &charClassMatcher{
+											pos:        position{line: 28, col: 23, offset: 740},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+									&choiceExpr{
+										pos: position{line: 28, col: 31, offset: 748},
+										alternatives: []any{
+											&seqExpr{
+												pos: position{line: 28, col: 31, offset: 748},
+												exprs: []any{
+													&zeroOrOneExpr{
+														pos: position{line: 28, col: 31, offset: 748},
+														expr: &litMatcher{
+															pos:        position{line: 28, col: 31, offset: 748},
+															val:        "\r",
+															ignoreCase: false,
+															want:       "\"\\r\"",
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 28, col: 37, offset: 754},
+														val:        "\n",
+														ignoreCase: false,
+														want:       "\"\\n\"",
+													},
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 28, col: 44, offset: 761},
+												name: "EOF",
+											},
+										},
+									},
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 26, col: 144, offset: 630},
-							name: "IgnoreTableEndParams",
-						},
-						&litMatcher{
-							pos:        position{line: 26, col: 165, offset: 651},
-							val:        ";",
-							ignoreCase: false,
-							want:       "\";\"",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "Grant",
-			pos:  position{line: 42, col: 1, offset: 912},
+			name: "SqlPlusCommand",
+			pos:  position{line: 33, col: 1, offset: 909},
 			expr: &actionExpr{
-				pos: position{line: 42, col: 10, offset: 921},
-				run: (*parser).callonGrant1,
+				pos: position{line: 33, col: 19, offset: 927},
+				run: (*parser).callonSqlPlusCommand1,
 				expr: &seqExpr{
-					pos: position{line: 42, col: 10, offset: 921},
+					pos: position{line: 33, col: 19, offset: 927},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 42, col: 10, offset: 921},
-							val:        "GRANT",
-							ignoreCase: false,
-							want:       "\"GRANT\"",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 42, col: 18, offset: 929},
-							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 18, offset: 929},
-								name: "WhiteSpace",
+						&choiceExpr{
+							pos: position{line: 33, col: 20, offset: 928},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 33, col: 20, offset: 928},
+									val:        "set",
+									ignoreCase: true,
+									want:       "\"SET\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 29, offset: 937},
+									val:        "prompt",
+									ignoreCase: true,
+									want:       "\"PROMPT\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 41, offset: 949},
+									val:        "remark",
+									ignoreCase: true,
+									want:       "\"REMARK\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 53, offset: 961},
+									val:        "rem",
+									ignoreCase: true,
+									want:       "\"REM\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 62, offset: 970},
+									val:        "spool",
+									ignoreCase: true,
+									want:       "\"SPOOL\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 73, offset: 981},
+									val:        "whenever",
+									ignoreCase: true,
+									want:       "\"WHENEVER\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 87, offset: 995},
+									val:        "exit",
+									ignoreCase: true,
+									want:       "\"EXIT\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 97, offset: 1005},
+									val:        "quit",
+									ignoreCase: true,
+									want:       "\"QUIT\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 107, offset: 1015},
+									val:        "define",
+									ignoreCase: true,
+									want:       "\"DEFINE\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 33, col: 119, offset: 1027},
+									val:        "undefine",
+									ignoreCase: true,
+									want:       "\"UNDEFINE\"i",
+								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 42, col: 30, offset: 941},
-							label: "grantType",
+						&notExpr{
+							pos: position{line: 33, col: 132, offset: 1040},
 							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 40, offset: 951},
-								name: "GrantType",
+								pos:  position{line: 33, col: 133, offset: 1041},
+								name: "IdentifierChar",
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 42, col: 50, offset: 961},
-							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 50, offset: 961},
-								name: "WhiteSpace",
+						&zeroOrMoreExpr{
+							pos: position{line: 33, col: 148, offset: 1056},
+							expr: &seqExpr{
+								pos: position{line: 33, col: 149, offset: 1057},
+								exprs: []any{
+									&notExpr{
+										pos: position{line: 33, col: 149, offset: 1057},
+										expr: &charClassMatcher{
+											pos:        position{line: 33, col: 150, offset: 1058},
+											val:        "[\\r\\n]",
+											chars:      []rune{'\r', '\n'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+									&anyMatcher{
+										line: 33, col: 157, offset: 1065,
+									},
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 42, col: 62, offset: 973},
-							val:        "ON",
-							ignoreCase: false,
-							want:       "\"ON\"",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 42, col: 67, offset: 978},
-							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 67, offset: 978},
-								name: "WhiteSpace",
-							},
+					},
+				},
+			},
+		},
+		{
+			name: "SkippedStatement",
+			pos:  position{line: 39, col: 1, offset: 1265},
+			expr: &actionExpr{
+				pos: position{line: 39, col: 21, offset: 1285},
+				run: (*parser).callonSkippedStatement1,
+				expr: &seqExpr{
+					pos: position{line: 39, col: 21, offset: 1285},
+					exprs: []any{
+						&andCodeExpr{
+							pos: position{line: 39, col: 21, offset: 1285},
+							run: (*parser).callonSkippedStatement3,
 						},
-						&labeledExpr{
-							pos:   position{line: 42, col: 79, offset: 990},
-							label: "grantWhere",
+						&notExpr{
+							pos: position{line: 39, col: 74, offset: 1338},
 							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 90, offset: 1001},
-								name: "TableName",
+								pos:  position{line: 39, col: 75, offset: 1339},
+								name: "EOF",
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 42, col: 100, offset: 1011},
-							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 100, offset: 1011},
-								name: "WhiteSpace",
+						&choiceExpr{
+							pos: position{line: 39, col: 80, offset: 1344},
+							alternatives: []any{
+								&ruleRefExpr{
+									pos:  position{line: 39, col: 80, offset: 1344},
+									name: "PlSqlBlock",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 39, col: 93, offset: 1357},
+									name: "SkippedText",
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 42, col: 112, offset: 1023},
-							val:        "TO",
-							ignoreCase: false,
-							want:       "\"TO\"",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 42, col: 117, offset: 1028},
-							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 117, offset: 1028},
-								name: "WhiteSpace",
+					},
+				},
+			},
+		},
+		{
+			name: "PlSqlBlock",
+			pos:  position{line: 42, col: 1, offset: 1495},
+			expr: &seqExpr{
+				pos: position{line: 42, col: 15, offset: 1509},
+				exprs: []any{
+					&ruleRefExpr{
+						pos:  position{line: 42, col: 15, offset: 1509},
+						name: "PlSqlStart",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 42, col: 26, offset: 1520},
+						expr: &choiceExpr{
+							pos: position{line: 42, col: 27, offset: 1521},
+							alternatives: []any{
+								&ruleRefExpr{
+									pos:  position{line: 42, col: 27, offset: 1521},
+									name: "LiteralString",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 42, col: 43, offset: 1537},
+									name: "LineComment",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 42, col: 57, offset: 1551},
+									name: "BlockComment",
+								},
+								&seqExpr{
+									pos: position{line: 42, col: 72, offset: 1566},
+									exprs: []any{
+										&notExpr{
+											pos: position{line: 42, col: 72, offset: 1566},
+											expr: &ruleRefExpr{
+												pos:  position{line: 42, col: 73, offset: 1567},
+												name: "SlashLine",
+											},
+										},
+										&anyMatcher{
+											line: 42, col: 83, offset: 1577,
+										},
+									},
+								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 42, col: 129, offset: 1040},
-							label: "grantWho",
-							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 138, offset: 1049},
-								name: "GrantWho",
+					},
+					&choiceExpr{
+						pos: position{line: 42, col: 88, offset: 1582},
+						alternatives: []any{
+							&ruleRefExpr{
+								pos:  position{line: 42, col: 88, offset: 1582},
+								name: "SlashLine",
 							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 42, col: 147, offset: 1058},
-							expr: &ruleRefExpr{
-								pos:  position{line: 42, col: 147, offset: 1058},
-								name: "WhiteSpace",
+							&ruleRefExpr{
+								pos:  position{line: 42, col: 100, offset: 1594},
+								name: "EOF",
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 42, col: 159, offset: 1070},
-							val:        ";",
-							ignoreCase: false,
-							want:       "\";\"",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "GrantWho",
-			pos:  position{line: 49, col: 1, offset: 1208},
-			expr: &choiceExpr{
-				pos: position{line: 49, col: 14, offset: 1221},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 49, col: 14, offset: 1221},
-						name: "LiteralString",
+			name: "PlSqlStart",
+			pos:  position{line: 43, col: 1, offset: 1600},
+			expr: &seqExpr{
+				pos: position{line: 43, col: 15, offset: 1614},
+				exprs: []any{
+					&choiceExpr{
+						pos: position{line: 43, col: 16, offset: 1615},
+						alternatives: []any{
+							&seqExpr{
+								pos: position{line: 43, col: 16, offset: 1615},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 43, col: 16, offset: 1615},
+										val:        "create",
+										ignoreCase: true,
+										want:       "\"CREATE\"i",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 43, col: 26, offset: 1625},
+										name: "WhiteSpace",
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 43, col: 37, offset: 1636},
+										expr: &seqExpr{
+											pos: position{line: 43, col: 38, offset: 1637},
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 43, col: 38, offset: 1637},
+													val:        "or",
+													ignoreCase: true,
+													want:       "\"OR\"i",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 43, col: 44, offset: 1643},
+													name: "WhiteSpace",
+												},
+												&litMatcher{
+													pos:        position{line: 43, col: 55, offset: 1654},
+													val:        "replace",
+													ignoreCase: true,
+													want:       "\"REPLACE\"i",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 43, col: 66, offset: 1665},
+													name: "WhiteSpace",
+												},
+											},
+										},
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 43, col: 79, offset: 1678},
+										expr: &seqExpr{
+											pos: position{line: 43, col: 80, offset: 1679},
+											exprs: []any{
+												&choiceExpr{
+													pos: position{line: 43, col: 81, offset: 1680},
+													alternatives: []any{
+														&litMatcher{
+															pos:        position{line: 43, col: 81, offset: 1680},
+															val:        "editionable",
+															ignoreCase: true,
+															want:       "\"EDITIONABLE\"i",
+														},
+														&litMatcher{
+															pos:        position{line: 43, col: 98, offset: 1697},
+															val:        "noneditionable",
+															ignoreCase: true,
+															want:       "\"NONEDITIONABLE\"i",
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 43, col: 117, offset: 1716},
+													name: "WhiteSpace",
+												},
+											},
+										},
+									},
+									&choiceExpr{
+										pos: position{line: 43, col: 131, offset: 1730},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 43, col: 131, offset: 1730},
+												val:        "procedure",
+												ignoreCase: true,
+												want:       "\"PROCEDURE\"i",
+											},
+											&litMatcher{
+												pos:        position{line: 43, col: 146, offset: 1745},
+												val:        "function",
+												ignoreCase: true,
+												want:       "\"FUNCTION\"i",
+											},
+											&litMatcher{
+												pos:        position{line: 43, col: 160, offset: 1759},
+												val:        "package",
+												ignoreCase: true,
+												want:       "\"PACKAGE\"i",
+											},
+											&litMatcher{
+												pos:        position{line: 43, col: 173, offset: 1772},
+												val:        "trigger",
+												ignoreCase: true,
+												want:       "\"TRIGGER\"i",
+											},
+											&litMatcher{
+												pos:        position{line: 43, col: 186, offset: 1785},
+												val:        "type",
+												ignoreCase: true,
+												want:       "\"TYPE\"i",
+											},
+										},
+									},
+								},
+							},
+							&litMatcher{
+								pos:        position{line: 43, col: 197, offset: 1796},
+								val:        "declare",
+								ignoreCase: true,
+								want:       "\"DECLARE\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 43, col: 210, offset: 1809},
+								val:        "begin",
+								ignoreCase: true,
+								want:       "\"BEGIN\"i",
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 49, col: 28, offset: 1235},
-						name: "GrantPublic",
+					&notExpr{
+						pos: position{line: 43, col: 220, offset: 1819},
+						expr: &ruleRefExpr{
+							pos:  position{line: 43, col: 221, offset: 1820},
+							name: "IdentifierChar",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "GrantPublic",
-			pos:  position{line: 50, col: 1, offset: 1249},
-			expr: &actionExpr{
-				pos: position{line: 50, col: 16, offset: 1264},
-				run: (*parser).callonGrantPublic1,
-				expr: &litMatcher{
-					pos:        position{line: 50, col: 16, offset: 1264},
-					val:        "PUBLIC",
-					ignoreCase: false,
-					want:       "\"PUBLIC\"",
-				},
-			},
+			name: "SkippedText",
+			pos:  position{line: 44, col: 1, offset: 1836},
+			expr: &seqExpr{
+				pos: position{line: 44, col: 16, offset: 1851},
+				exprs: []any{
+					&zeroOrMoreExpr{
+						pos: position{line: 44, col: 16, offset: 1851},
+						expr: &choiceExpr{
+							pos: position{line: 44, col: 17, offset: 1852},
+							alternatives: []any{
+								&ruleRefExpr{
+									pos:  position{line: 44, col: 17, offset: 1852},
+									name: "LiteralString",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 44, col: 33, offset: 1868},
+									name: "LineComment",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 44, col: 47, offset: 1882},
+									name: "BlockComment",
+								},
+								&seqExpr{
+									pos: position{line: 44, col: 62, offset: 1897},
+									exprs: []any{
+										&notExpr{
+											pos: position{line: 44, col: 62, offset: 1897},
+											expr: &litMatcher{
+												pos:        position{line: 44, col: 63, offset: 1898},
+												val:        ";",
+												ignoreCase: false,
+												want:       "\";\"",
+											},
+										},
+										&notExpr{
+											pos: position{line: 44, col: 67, offset: 1902},
+											expr: &ruleRefExpr{
+												pos:  position{line: 44, col: 68, offset: 1903},
+												name: "SlashLine",
+											},
+										},
+										&anyMatcher{
+											line: 44, col: 78, offset: 1913,
+										},
+									},
+								},
+							},
+						},
+					},
+					&choiceExpr{
+						pos: position{line: 44, col: 83, offset: 1918},
+						alternatives: []any{
+							&litMatcher{
+								pos:        position{line: 44, col: 83, offset: 1918},
+								val:        ";",
+								ignoreCase: false,
+								want:       "\";\"",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 44, col: 89, offset: 1924},
+								name: "SlashLine",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 44, col: 101, offset: 1936},
+								name: "EOF",
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			name: "GrantType",
-			pos:  position{line: 53, col: 1, offset: 1309},
-			expr: &actionExpr{
-				pos: position{line: 53, col: 14, offset: 1322},
-				run: (*parser).callonGrantType1,
-				expr: &choiceExpr{
-					pos: position{line: 53, col: 15, offset: 1323},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 53, col: 15, offset: 1323},
-							val:        "UPDATE",
-							ignoreCase: false,
-							want:       "\"UPDATE\"",
-						},
-						&litMatcher{
-							pos:        position{line: 53, col: 26, offset: 1334},
-							val:        "SELECT",
+			name: "SlashLine",
+			pos:  position{line: 45, col: 1, offset: 1942},
+			expr: &seqExpr{
+				pos: position{line: 45, col: 14, offset: 1955},
+				exprs: []any{
+					&zeroOrOneExpr{
+						pos: position{line: 45, col: 14, offset: 1955},
+						expr: &litMatcher{
+							pos:        position{line: 45, col: 14, offset: 1955},
+							val:        "\r",
 							ignoreCase: false,
-							want:       "\"SELECT\"",
+							want:       "\"\\r\"",
 						},
-						&litMatcher{
-							pos:        position{line: 53, col: 37, offset: 1345},
-							val:        "INSERT",
+					},
+					&litMatcher{
+						pos:        position{line: 45, col: 20, offset: 1961},
+						val:        "\n",
+						ignoreCase: false,
+						want:       "\"\\n\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 45, col: 25, offset: 1966},
+						expr: &charClassMatcher{
+							pos:        position{line: 45, col: 25, offset: 1966},
+							val:        "[ \\t]",
+							chars:      []rune{' ', '\t'},
 							ignoreCase: false,
-							want:       "\"INSERT\"",
+							inverted:   false,
 						},
-						&litMatcher{
-							pos:        position{line: 53, col: 48, offset: 1356},
-							val:        "DELETE",
-							ignoreCase: false,
-							want:       "\"DELETE\"",
+					},
+					&litMatcher{
+						pos:        position{line: 45, col: 32, offset: 1973},
+						val:        "/",
+						ignoreCase: false,
+						want:       "\"/\"",
+					},
+					&andExpr{
+						pos: position{line: 45, col: 36, offset: 1977},
+						expr: &seqExpr{
+							pos: position{line: 45, col: 38, offset: 1979},
+							exprs: []any{
+								&zeroOrMoreExpr{
+									pos: position{line: 45, col: 38, offset: 1979},
+									expr: &charClassMatcher{
+										pos:        position{line: 45, col: 38, offset: 1979},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 45, col: 46, offset: 1987},
+									alternatives: []any{
+										&seqExpr{
+											pos: position{line: 45, col: 46, offset: 1987},
+											exprs: []any{
+												&zeroOrOneExpr{
+													pos: position{line: 45, col: 46, offset: 1987},
+													expr: &litMatcher{
+														pos:        position{line: 45, col: 46, offset: 1987},
+														val:        "\r",
+														ignoreCase: false,
+														want:       "\"\\r\"",
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 45, col: 52, offset: 1993},
+													val:        "\n",
+													ignoreCase: false,
+													want:       "\"\\n\"",
+												},
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 45, col: 59, offset: 2000},
+											name: "EOF",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "Comment",
-			pos:  position{line: 57, col: 1, offset: 1404},
+			name: "CreateTable",
+			pos:  position{line: 48, col: 1, offset: 2011},
 			expr: &actionExpr{
-				pos: position{line: 57, col: 12, offset: 1415},
-				run: (*parser).callonComment1,
+				pos: position{line: 48, col: 16, offset: 2026},
+				run: (*parser).callonCreateTable1,
 				expr: &seqExpr{
-					pos: position{line: 57, col: 12, offset: 1415},
+					pos: position{line: 48, col: 16, offset: 2026},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 57, col: 12, offset: 1415},
-							val:        "COMMENT",
+							pos:        position{line: 48, col: 16, offset: 2026},
+							val:        "CREATE",
 							ignoreCase: false,
-							want:       "\"COMMENT\"",
+							want:       "\"CREATE\"",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 57, col: 22, offset: 1425},
+							pos: position{line: 48, col: 25, offset: 2035},
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 22, offset: 1425},
+								pos:  position{line: 48, col: 25, offset: 2035},
 								name: "WhiteSpace",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 48, col: 37, offset: 2047},
+							label: "temp",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 48, col: 42, offset: 2052},
+								expr: &seqExpr{
+									pos: position{line: 48, col: 43, offset: 2053},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 48, col: 43, offset: 2053},
+											name: "TemporaryKind",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 48, col: 57, offset: 2067},
+											name: "WhiteSpace",
+										},
+									},
+								},
+							},
+						},
 						&litMatcher{
-							pos:        position{line: 57, col: 34, offset: 1437},
-							val:        "ON",
+							pos:        position{line: 48, col: 70, offset: 2080},
+							val:        "TABLE",
 							ignoreCase: false,
-							want:       "\"ON\"",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 57, col: 39, offset: 1442},
-							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 39, offset: 1442},
-								name: "WhiteSpace",
-							},
+							want:       "\"TABLE\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 51, offset: 1454},
-							name: "CommentOnKeyword",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 57, col: 68, offset: 1471},
-							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 68, offset: 1471},
-								name: "WhiteSpace",
-							},
+							pos:  position{line: 48, col: 78, offset: 2088},
+							name: "WhiteSpace",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 80, offset: 1483},
+							pos:   position{line: 48, col: 89, offset: 2099},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 85, offset: 1488},
+								pos:  position{line: 48, col: 94, offset: 2104},
 								name: "TableName",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 57, col: 95, offset: 1498},
-							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 95, offset: 1498},
-								name: "WhiteSpace",
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 57, col: 107, offset: 1510},
-							val:        "IS",
-							ignoreCase: false,
-							want:       "\"IS\"",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 57, col: 112, offset: 1515},
+							pos: position{line: 48, col: 104, offset: 2114},
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 112, offset: 1515},
+								pos:  position{line: 48, col: 104, offset: 2114},
 								name: "WhiteSpace",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 124, offset: 1527},
-							label: "text",
+							pos:   position{line: 48, col: 116, offset: 2126},
+							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 129, offset: 1532},
-								name: "LiteralString",
+								pos:  position{line: 48, col: 121, offset: 2131},
+								name: "TableBody",
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 57, col: 143, offset: 1546},
+						&labeledExpr{
+							pos:   position{line: 48, col: 131, offset: 2141},
+							label: "physical",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 143, offset: 1546},
-								name: "WhiteSpace",
+								pos:  position{line: 48, col: 140, offset: 2150},
+								name: "TablePhysical",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 57, col: 155, offset: 1558},
+							pos:        position{line: 48, col: 154, offset: 2164},
 							val:        ";",
 							ignoreCase: false,
 							want:       "\";\"",
@@ -461,326 +796,263 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "CommentOnKeyword",
-			pos:  position{line: 64, col: 1, offset: 1676},
-			expr: &choiceExpr{
-				pos: position{line: 64, col: 21, offset: 1696},
-				alternatives: []any{
-					&litMatcher{
-						pos:        position{line: 64, col: 21, offset: 1696},
-						val:        "TABLE",
-						ignoreCase: false,
-						want:       "\"TABLE\"",
-					},
-					&litMatcher{
-						pos:        position{line: 64, col: 31, offset: 1706},
-						val:        "COLUMN",
-						ignoreCase: false,
-						want:       "\"COLUMN\"",
-					},
-				},
-			},
-		},
-		{
-			name: "TableName",
-			pos:  position{line: 66, col: 1, offset: 1718},
+			name: "CreateIndex",
+			pos:  position{line: 84, col: 1, offset: 3057},
 			expr: &actionExpr{
-				pos: position{line: 66, col: 14, offset: 1731},
-				run: (*parser).callonTableName1,
+				pos: position{line: 84, col: 16, offset: 3072},
+				run: (*parser).callonCreateIndex1,
 				expr: &seqExpr{
-					pos: position{line: 66, col: 14, offset: 1731},
+					pos: position{line: 84, col: 16, offset: 3072},
 					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 66, col: 14, offset: 1731},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 66, col: 20, offset: 1737},
-								name: "TableNamePart",
-							},
+						&litMatcher{
+							pos:        position{line: 84, col: 16, offset: 3072},
+							val:        "CREATE",
+							ignoreCase: false,
+							want:       "\"CREATE\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 84, col: 25, offset: 3081},
+							name: "WhiteSpace",
 						},
 						&labeledExpr{
-							pos:   position{line: 66, col: 34, offset: 1751},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 66, col: 39, offset: 1756},
+							pos:   position{line: 84, col: 36, offset: 3092},
+							label: "kind",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 84, col: 41, offset: 3097},
 								expr: &seqExpr{
-									pos: position{line: 66, col: 40, offset: 1757},
+									pos: position{line: 84, col: 42, offset: 3098},
 									exprs: []any{
-										&litMatcher{
-											pos:        position{line: 66, col: 40, offset: 1757},
-											val:        ".",
-											ignoreCase: false,
-											want:       "\".\"",
+										&choiceExpr{
+											pos: position{line: 84, col: 43, offset: 3099},
+											alternatives: []any{
+												&litMatcher{
+													pos:        position{line: 84, col: 43, offset: 3099},
+													val:        "UNIQUE",
+													ignoreCase: false,
+													want:       "\"UNIQUE\"",
+												},
+												&litMatcher{
+													pos:        position{line: 84, col: 54, offset: 3110},
+													val:        "BITMAP",
+													ignoreCase: false,
+													want:       "\"BITMAP\"",
+												},
+											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 66, col: 44, offset: 1761},
-											name: "TableNamePart",
+											pos:  position{line: 84, col: 64, offset: 3120},
+											name: "WhiteSpace",
 										},
 									},
 								},
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "TableNamePart",
-			pos:  position{line: 80, col: 1, offset: 2173},
-			expr: &choiceExpr{
-				pos: position{line: 80, col: 18, offset: 2190},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 80, col: 18, offset: 2190},
-						name: "LiteralString",
-					},
-					&actionExpr{
-						pos: position{line: 80, col: 34, offset: 2206},
-						run: (*parser).callonTableNamePart3,
-						expr: &ruleRefExpr{
-							pos:  position{line: 80, col: 34, offset: 2206},
-							name: "Identifier",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "TableBody",
-			pos:  position{line: 84, col: 1, offset: 2255},
-			expr: &ruleRefExpr{
-				pos:  position{line: 84, col: 14, offset: 2268},
-				name: "TableBodyDef",
-			},
-		},
-		{
-			name: "TableBodyDef",
-			pos:  position{line: 86, col: 1, offset: 2305},
-			expr: &actionExpr{
-				pos: position{line: 86, col: 17, offset: 2321},
-				run: (*parser).callonTableBodyDef1,
-				expr: &seqExpr{
-					pos: position{line: 86, col: 17, offset: 2321},
-					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 86, col: 17, offset: 2321},
-							val:        "(",
+							pos:        position{line: 84, col: 77, offset: 3133},
+							val:        "INDEX",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\"INDEX\"",
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 86, col: 21, offset: 2325},
+						&ruleRefExpr{
+							pos:  position{line: 84, col: 85, offset: 3141},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 84, col: 96, offset: 3152},
+							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 86, col: 21, offset: 2325},
-								name: "WhiteSpace",
+								pos:  position{line: 84, col: 101, offset: 3157},
+								name: "TableName",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 84, col: 111, offset: 3167},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 84, col: 122, offset: 3178},
+							val:        "ON",
+							ignoreCase: false,
+							want:       "\"ON\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 84, col: 127, offset: 3183},
+							name: "WhiteSpace",
+						},
 						&labeledExpr{
-							pos:   position{line: 86, col: 33, offset: 2337},
-							label: "cols",
+							pos:   position{line: 84, col: 138, offset: 3194},
+							label: "table",
 							expr: &ruleRefExpr{
-								pos:  position{line: 86, col: 38, offset: 2342},
-								name: "Columns",
+								pos:  position{line: 84, col: 144, offset: 3200},
+								name: "TableName",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 86, col: 46, offset: 2350},
+							pos: position{line: 84, col: 154, offset: 3210},
 							expr: &ruleRefExpr{
-								pos:  position{line: 86, col: 46, offset: 2350},
+								pos:  position{line: 84, col: 154, offset: 3210},
 								name: "WhiteSpace",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 86, col: 58, offset: 2362},
-							val:        ")",
+							pos:        position{line: 84, col: 166, offset: 3222},
+							val:        "(",
 							ignoreCase: false,
-							want:       "\")\"",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Columns",
-			pos:  position{line: 90, col: 1, offset: 2394},
-			expr: &actionExpr{
-				pos: position{line: 90, col: 12, offset: 2405},
-				run: (*parser).callonColumns1,
-				expr: &labeledExpr{
-					pos:   position{line: 90, col: 12, offset: 2405},
-					label: "items",
-					expr: &zeroOrMoreExpr{
-						pos: position{line: 90, col: 18, offset: 2411},
-						expr: &seqExpr{
-							pos: position{line: 90, col: 19, offset: 2412},
-							exprs: []any{
-								&zeroOrOneExpr{
-									pos: position{line: 90, col: 19, offset: 2412},
-									expr: &ruleRefExpr{
-										pos:  position{line: 90, col: 19, offset: 2412},
-										name: "WhiteSpace",
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 90, col: 31, offset: 2424},
-									expr: &litMatcher{
-										pos:        position{line: 90, col: 31, offset: 2424},
-										val:        ",",
-										ignoreCase: false,
-										want:       "\",\"",
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 90, col: 36, offset: 2429},
-									expr: &ruleRefExpr{
-										pos:  position{line: 90, col: 36, offset: 2429},
-										name: "WhiteSpace",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 90, col: 48, offset: 2441},
-									name: "Column",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Column",
-			pos:  position{line: 114, col: 1, offset: 2846},
-			expr: &actionExpr{
-				pos: position{line: 114, col: 11, offset: 2856},
-				run: (*parser).callonColumn1,
-				expr: &seqExpr{
-					pos: position{line: 114, col: 11, offset: 2856},
-					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 114, col: 11, offset: 2856},
-							label: "colname",
-							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 19, offset: 2864},
-								name: "ColumnName",
-							},
+							want:       "\"(\"",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 114, col: 30, offset: 2875},
+							pos: position{line: 84, col: 170, offset: 3226},
 							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 30, offset: 2875},
+								pos:  position{line: 84, col: 170, offset: 3226},
 								name: "WhiteSpace",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 114, col: 42, offset: 2887},
-							label: "coltype",
-							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 50, offset: 2895},
-								name: "ColumnType",
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 114, col: 61, offset: 2906},
-							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 61, offset: 2906},
-								name: "WhiteSpace",
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 114, col: 73, offset: 2918},
-							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 73, offset: 2918},
-								name: "ColumnExtras",
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 114, col: 87, offset: 2932},
+							pos:   position{line: 84, col: 182, offset: 3238},
+							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 87, offset: 2932},
-								name: "WhiteSpace",
+								pos:  position{line: 84, col: 188, offset: 3244},
+								name: "IndexElement",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 114, col: 99, offset: 2944},
-							label: "_c",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 114, col: 102, offset: 2947},
-								expr: &ruleRefExpr{
-									pos:  position{line: 114, col: 102, offset: 2947},
-									name: "ColumnTypeArgs",
+							pos:   position{line: 84, col: 201, offset: 3257},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 84, col: 206, offset: 3262},
+								expr: &seqExpr{
+									pos: position{line: 84, col: 207, offset: 3263},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 84, col: 207, offset: 3263},
+											expr: &ruleRefExpr{
+												pos:  position{line: 84, col: 207, offset: 3263},
+												name: "WhiteSpace",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 84, col: 219, offset: 3275},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 84, col: 223, offset: 3279},
+											expr: &ruleRefExpr{
+												pos:  position{line: 84, col: 223, offset: 3279},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 84, col: 235, offset: 3291},
+											name: "IndexElement",
+										},
+									},
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 114, col: 118, offset: 2963},
+							pos: position{line: 84, col: 250, offset: 3306},
 							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 118, offset: 2963},
+								pos:  position{line: 84, col: 250, offset: 3306},
 								name: "WhiteSpace",
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 114, col: 130, offset: 2975},
-							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 130, offset: 2975},
-								name: "PreColumnDefault",
-							},
+						&litMatcher{
+							pos:        position{line: 84, col: 262, offset: 3318},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 114, col: 148, offset: 2993},
-							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 148, offset: 2993},
-								name: "WhiteSpace",
+						&labeledExpr{
+							pos:   position{line: 84, col: 266, offset: 3322},
+							label: "opts",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 84, col: 271, offset: 3327},
+								expr: &seqExpr{
+									pos: position{line: 84, col: 272, offset: 3328},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 84, col: 272, offset: 3328},
+											expr: &ruleRefExpr{
+												pos:  position{line: 84, col: 272, offset: 3328},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 84, col: 284, offset: 3340},
+											name: "IndexOption",
+										},
+									},
+								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 114, col: 160, offset: 3005},
-							label: "defVal",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 114, col: 167, offset: 3012},
-								expr: &ruleRefExpr{
-									pos:  position{line: 114, col: 167, offset: 3012},
-									name: "ColumnDefault",
-								},
+							pos:   position{line: 84, col: 298, offset: 3354},
+							label: "unparsed",
+							expr: &ruleRefExpr{
+								pos:  position{line: 84, col: 307, offset: 3363},
+								name: "IndexUnparsed",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 84, col: 321, offset: 3377},
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "PreColumnDefault",
-			pos:  position{line: 151, col: 1, offset: 3722},
-			expr: &litMatcher{
-				pos:        position{line: 151, col: 21, offset: 3742},
-				val:        "WITH LOCAL TIME ZONE",
-				ignoreCase: false,
-				want:       "\"WITH LOCAL TIME ZONE\"",
-			},
-		},
-		{
-			name: "ColumnExtras",
-			pos:  position{line: 152, col: 1, offset: 3766},
-			expr: &oneOrMoreExpr{
-				pos: position{line: 152, col: 17, offset: 3782},
+			name: "IndexElement",
+			pos:  position{line: 114, col: 1, offset: 4215},
+			expr: &actionExpr{
+				pos: position{line: 114, col: 17, offset: 4231},
+				run: (*parser).callonIndexElement1,
 				expr: &seqExpr{
-					pos: position{line: 152, col: 18, offset: 3783},
+					pos: position{line: 114, col: 17, offset: 4231},
 					exprs: []any{
-						&zeroOrOneExpr{
-							pos: position{line: 152, col: 18, offset: 3783},
+						&labeledExpr{
+							pos:   position{line: 114, col: 17, offset: 4231},
+							label: "elem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 152, col: 18, offset: 3783},
-								name: "WhiteSpace",
+								pos:  position{line: 114, col: 22, offset: 4236},
+								name: "IndexElementBody",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 152, col: 30, offset: 3795},
-							name: "ColumnExtra",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 152, col: 42, offset: 3807},
-							expr: &ruleRefExpr{
-								pos:  position{line: 152, col: 42, offset: 3807},
-								name: "WhiteSpace",
+						&labeledExpr{
+							pos:   position{line: 114, col: 39, offset: 4253},
+							label: "order",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 114, col: 45, offset: 4259},
+								expr: &seqExpr{
+									pos: position{line: 114, col: 46, offset: 4260},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 114, col: 46, offset: 4260},
+											name: "WhiteSpace",
+										},
+										&choiceExpr{
+											pos: position{line: 114, col: 58, offset: 4272},
+											alternatives: []any{
+												&litMatcher{
+													pos:        position{line: 114, col: 58, offset: 4272},
+													val:        "ASC",
+													ignoreCase: false,
+													want:       "\"ASC\"",
+												},
+												&litMatcher{
+													pos:        position{line: 114, col: 66, offset: 4280},
+													val:        "DESC",
+													ignoreCase: false,
+													want:       "\"DESC\"",
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -788,275 +1060,276 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ColumnExtra",
-			pos:  position{line: 153, col: 1, offset: 3822},
+			name: "IndexElementBody",
+			pos:  position{line: 122, col: 1, offset: 4460},
 			expr: &choiceExpr{
-				pos: position{line: 153, col: 16, offset: 3837},
+				pos: position{line: 122, col: 21, offset: 4480},
 				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 16, offset: 3837},
-						name: "ColumnExtraGen",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 33, offset: 3854},
-						name: "ColumnExtraMinValue",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 55, offset: 3876},
-						name: "ColumnExtraMaxValue",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 77, offset: 3898},
-						name: "ColumnExtraInc",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 94, offset: 3915},
-						name: "ColumnExtraStartWith",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 117, offset: 3938},
-						name: "ColumnExtraNoOrder",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 138, offset: 3959},
-						name: "ColumnExtraCacheSize",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 161, offset: 3982},
-						name: "ColumnExtraNoCycle",
+					&actionExpr{
+						pos: position{line: 122, col: 21, offset: 4480},
+						run: (*parser).callonIndexElementBody2,
+						expr: &seqExpr{
+							pos: position{line: 122, col: 21, offset: 4480},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 122, col: 21, offset: 4480},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 122, col: 26, offset: 4485},
+										name: "TableNamePart",
+									},
+								},
+								&andExpr{
+									pos: position{line: 122, col: 40, offset: 4499},
+									expr: &ruleRefExpr{
+										pos:  position{line: 122, col: 41, offset: 4500},
+										name: "IndexElementEnd",
+									},
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 182, offset: 4003},
-						name: "ColumnExtraNoKeep",
+					&actionExpr{
+						pos: position{line: 124, col: 5, offset: 4583},
+						run: (*parser).callonIndexElementBody8,
+						expr: &seqExpr{
+							pos: position{line: 124, col: 5, offset: 4583},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 124, col: 5, offset: 4583},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 124, col: 7, offset: 4585},
+										name: "ExpressionTree",
+									},
+								},
+								&andExpr{
+									pos: position{line: 124, col: 22, offset: 4600},
+									expr: &ruleRefExpr{
+										pos:  position{line: 124, col: 23, offset: 4601},
+										name: "IndexElementEnd",
+									},
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 153, col: 202, offset: 4023},
-						name: "ColumnExtraNoScale",
+					&actionExpr{
+						pos: position{line: 127, col: 5, offset: 4716},
+						run: (*parser).callonIndexElementBody14,
+						expr: &ruleRefExpr{
+							pos:  position{line: 127, col: 5, offset: 4716},
+							name: "IndexExpression",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ColumnExtraGen",
-			pos:  position{line: 154, col: 1, offset: 4043},
-			expr: &litMatcher{
-				pos:        position{line: 154, col: 19, offset: 4061},
-				val:        "GENERATED ALWAYS AS IDENTITY",
-				ignoreCase: false,
-				want:       "\"GENERATED ALWAYS AS IDENTITY\"",
-			},
-		},
-		{
-			name: "ColumnExtraMinValue",
-			pos:  position{line: 155, col: 1, offset: 4093},
+			name: "IndexElementEnd",
+			pos:  position{line: 131, col: 1, offset: 4853},
 			expr: &seqExpr{
-				pos: position{line: 155, col: 24, offset: 4116},
+				pos: position{line: 131, col: 20, offset: 4872},
 				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 155, col: 24, offset: 4116},
-						val:        "MINVALUE",
-						ignoreCase: false,
-						want:       "\"MINVALUE\"",
-					},
 					&zeroOrOneExpr{
-						pos: position{line: 155, col: 35, offset: 4127},
+						pos: position{line: 131, col: 20, offset: 4872},
 						expr: &ruleRefExpr{
-							pos:  position{line: 155, col: 35, offset: 4127},
+							pos:  position{line: 131, col: 20, offset: 4872},
 							name: "WhiteSpace",
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 155, col: 47, offset: 4139},
-						name: "Digits",
+					&choiceExpr{
+						pos: position{line: 131, col: 33, offset: 4885},
+						alternatives: []any{
+							&charClassMatcher{
+								pos:        position{line: 131, col: 33, offset: 4885},
+								val:        "[,)]",
+								chars:      []rune{',', ')'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+							&litMatcher{
+								pos:        position{line: 131, col: 40, offset: 4892},
+								val:        "ASC",
+								ignoreCase: false,
+								want:       "\"ASC\"",
+							},
+							&litMatcher{
+								pos:        position{line: 131, col: 48, offset: 4900},
+								val:        "DESC",
+								ignoreCase: false,
+								want:       "\"DESC\"",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ColumnExtraMaxValue",
-			pos:  position{line: 156, col: 1, offset: 4147},
-			expr: &seqExpr{
-				pos: position{line: 156, col: 24, offset: 4170},
-				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 156, col: 24, offset: 4170},
-						val:        "MAXVALUE",
-						ignoreCase: false,
-						want:       "\"MAXVALUE\"",
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 156, col: 35, offset: 4181},
-						expr: &ruleRefExpr{
-							pos:  position{line: 156, col: 35, offset: 4181},
-							name: "WhiteSpace",
+			name: "IndexExpression",
+			pos:  position{line: 134, col: 1, offset: 4993},
+			expr: &oneOrMoreExpr{
+				pos: position{line: 134, col: 20, offset: 5012},
+				expr: &choiceExpr{
+					pos: position{line: 134, col: 21, offset: 5013},
+					alternatives: []any{
+						&ruleRefExpr{
+							pos:  position{line: 134, col: 21, offset: 5013},
+							name: "LiteralString",
+						},
+						&seqExpr{
+							pos: position{line: 134, col: 37, offset: 5029},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 134, col: 37, offset: 5029},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 134, col: 41, offset: 5033},
+									name: "ParenBody",
+								},
+								&litMatcher{
+									pos:        position{line: 134, col: 51, offset: 5043},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+						&seqExpr{
+							pos: position{line: 134, col: 57, offset: 5049},
+							exprs: []any{
+								&notExpr{
+									pos: position{line: 134, col: 57, offset: 5049},
+									expr: &seqExpr{
+										pos: position{line: 134, col: 59, offset: 5051},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 134, col: 59, offset: 5051},
+												name: "WhiteSpace",
+											},
+											&choiceExpr{
+												pos: position{line: 134, col: 71, offset: 5063},
+												alternatives: []any{
+													&litMatcher{
+														pos:        position{line: 134, col: 71, offset: 5063},
+														val:        "ASC",
+														ignoreCase: false,
+														want:       "\"ASC\"",
+													},
+													&litMatcher{
+														pos:        position{line: 134, col: 79, offset: 5071},
+														val:        "DESC",
+														ignoreCase: false,
+														want:       "\"DESC\"",
+													},
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 134, col: 87, offset: 5079},
+												name: "IndexElementEnd",
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 134, col: 104, offset: 5096},
+									expr: &charClassMatcher{
+										pos:        position{line: 134, col: 105, offset: 5097},
+										val:        "[,()'\"]",
+										chars:      []rune{',', '(', ')', '\'', '"'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&anyMatcher{
+									line: 134, col: 113, offset: 5105,
+								},
+							},
 						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 156, col: 47, offset: 4193},
-						name: "Digits",
 					},
 				},
 			},
 		},
 		{
-			name: "ColumnExtraInc",
-			pos:  position{line: 157, col: 1, offset: 4201},
-			expr: &seqExpr{
-				pos: position{line: 157, col: 19, offset: 4219},
-				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 157, col: 19, offset: 4219},
-						val:        "INCREMENT BY",
-						ignoreCase: false,
-						want:       "\"INCREMENT BY\"",
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 157, col: 34, offset: 4234},
-						expr: &ruleRefExpr{
-							pos:  position{line: 157, col: 34, offset: 4234},
-							name: "WhiteSpace",
-						},
+			name: "IndexOption",
+			pos:  position{line: 136, col: 1, offset: 5112},
+			expr: &choiceExpr{
+				pos: position{line: 136, col: 16, offset: 5127},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 136, col: 16, offset: 5127},
+						name: "PhysicalOption",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 157, col: 46, offset: 4246},
-						name: "Digits",
+						pos:  position{line: 136, col: 33, offset: 5144},
+						name: "LocalIndexOption",
 					},
 				},
 			},
 		},
 		{
-			name: "ColumnExtraStartWith",
-			pos:  position{line: 158, col: 1, offset: 4254},
-			expr: &seqExpr{
-				pos: position{line: 158, col: 25, offset: 4278},
-				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 158, col: 25, offset: 4278},
-						val:        "START WITH",
-						ignoreCase: false,
-						want:       "\"START WITH\"",
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 158, col: 38, offset: 4291},
-						expr: &ruleRefExpr{
-							pos:  position{line: 158, col: 38, offset: 4291},
-							name: "WhiteSpace",
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 158, col: 50, offset: 4303},
-						name: "Digits",
-					},
-				},
-			},
-		},
-		{
-			name: "ColumnExtraCacheSize",
-			pos:  position{line: 159, col: 1, offset: 4311},
-			expr: &seqExpr{
-				pos: position{line: 159, col: 25, offset: 4335},
-				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 159, col: 25, offset: 4335},
-						val:        "CACHE",
-						ignoreCase: false,
-						want:       "\"CACHE\"",
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 159, col: 33, offset: 4343},
-						expr: &ruleRefExpr{
-							pos:  position{line: 159, col: 33, offset: 4343},
-							name: "WhiteSpace",
+			name: "IndexUnparsed",
+			pos:  position{line: 139, col: 1, offset: 5209},
+			expr: &actionExpr{
+				pos: position{line: 139, col: 18, offset: 5226},
+				run: (*parser).callonIndexUnparsed1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 139, col: 18, offset: 5226},
+					expr: &seqExpr{
+						pos: position{line: 139, col: 19, offset: 5227},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 139, col: 19, offset: 5227},
+								expr: &litMatcher{
+									pos:        position{line: 139, col: 20, offset: 5228},
+									val:        ";",
+									ignoreCase: false,
+									want:       "\";\"",
+								},
+							},
+							&anyMatcher{
+								line: 139, col: 24, offset: 5232,
+							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 159, col: 45, offset: 4355},
-						name: "Digits",
-					},
 				},
 			},
 		},
 		{
-			name: "ColumnExtraNoOrder",
-			pos:  position{line: 160, col: 1, offset: 4363},
-			expr: &litMatcher{
-				pos:        position{line: 160, col: 23, offset: 4385},
-				val:        "NOORDER",
-				ignoreCase: false,
-				want:       "\"NOORDER\"",
-			},
-		},
-		{
-			name: "ColumnExtraNoCycle",
-			pos:  position{line: 161, col: 1, offset: 4396},
-			expr: &litMatcher{
-				pos:        position{line: 161, col: 23, offset: 4418},
-				val:        "NOCYCLE",
-				ignoreCase: false,
-				want:       "\"NOCYCLE\"",
-			},
-		},
-		{
-			name: "ColumnExtraNoKeep",
-			pos:  position{line: 162, col: 1, offset: 4429},
-			expr: &litMatcher{
-				pos:        position{line: 162, col: 22, offset: 4450},
-				val:        "NOKEEP",
-				ignoreCase: false,
-				want:       "\"NOKEEP\"",
-			},
-		},
-		{
-			name: "ColumnExtraNoScale",
-			pos:  position{line: 163, col: 1, offset: 4460},
-			expr: &litMatcher{
-				pos:        position{line: 163, col: 23, offset: 4482},
-				val:        "NOSCALE",
-				ignoreCase: false,
-				want:       "\"NOSCALE\"",
-			},
-		},
-		{
-			name: "ColumnDefault",
-			pos:  position{line: 166, col: 1, offset: 4497},
+			name: "LocalIndexOption",
+			pos:  position{line: 143, col: 1, offset: 5293},
 			expr: &actionExpr{
-				pos: position{line: 166, col: 18, offset: 4514},
-				run: (*parser).callonColumnDefault1,
+				pos: position{line: 143, col: 21, offset: 5313},
+				run: (*parser).callonLocalIndexOption1,
 				expr: &seqExpr{
-					pos: position{line: 166, col: 18, offset: 4514},
+					pos: position{line: 143, col: 21, offset: 5313},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 166, col: 18, offset: 4514},
-							val:        "DEFAULT",
+							pos:        position{line: 143, col: 21, offset: 5313},
+							val:        "LOCAL",
 							ignoreCase: false,
-							want:       "\"DEFAULT\"",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 166, col: 28, offset: 4524},
-							expr: &ruleRefExpr{
-								pos:  position{line: 166, col: 28, offset: 4524},
-								name: "WhiteSpace",
-							},
+							want:       "\"LOCAL\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 166, col: 40, offset: 4536},
-							label: "val",
+							pos:   position{line: 143, col: 29, offset: 5321},
+							label: "parts",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 166, col: 44, offset: 4540},
-								expr: &choiceExpr{
-									pos: position{line: 166, col: 45, offset: 4541},
-									alternatives: []any{
-										&ruleRefExpr{
-											pos:  position{line: 166, col: 45, offset: 4541},
-											name: "LiteralValue",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 166, col: 60, offset: 4556},
-											name: "ColumnDefaultKeyword",
+								pos: position{line: 143, col: 35, offset: 5327},
+								expr: &seqExpr{
+									pos: position{line: 143, col: 36, offset: 5328},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 143, col: 36, offset: 5328},
+											expr: &ruleRefExpr{
+												pos:  position{line: 143, col: 36, offset: 5328},
+												name: "WhiteSpace",
+											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 166, col: 83, offset: 4579},
-											name: "FunctionCall",
+											pos:  position{line: 143, col: 48, offset: 5340},
+											name: "ParenText",
 										},
 									},
 								},
@@ -1067,173 +1340,129 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ColumnDefaultKeyword",
-			pos:  position{line: 178, col: 1, offset: 4801},
-			expr: &choiceExpr{
-				pos: position{line: 178, col: 26, offset: 4826},
-				alternatives: []any{
-					&litMatcher{
-						pos:        position{line: 178, col: 26, offset: 4826},
-						val:        "SYSDATE",
-						ignoreCase: false,
-						want:       "\"SYSDATE\"",
-					},
-					&litMatcher{
-						pos:        position{line: 178, col: 38, offset: 4838},
-						val:        "sysdate",
-						ignoreCase: false,
-						want:       "\"sysdate\"",
-					},
-					&litMatcher{
-						pos:        position{line: 178, col: 50, offset: 4850},
-						val:        "localtimestamp",
-						ignoreCase: false,
-						want:       "\"localtimestamp\"",
-					},
-					&litMatcher{
-						pos:        position{line: 178, col: 69, offset: 4869},
-						val:        "systimestamp",
-						ignoreCase: false,
-						want:       "\"systimestamp\"",
-					},
-					&litMatcher{
-						pos:        position{line: 178, col: 86, offset: 4886},
-						val:        "NULL",
-						ignoreCase: false,
-						want:       "\"NULL\"",
-					},
-					&litMatcher{
-						pos:        position{line: 178, col: 95, offset: 4895},
-						val:        "null",
-						ignoreCase: false,
-						want:       "\"null\"",
-					},
-				},
-			},
-		},
-		{
-			name: "FunctionCall",
-			pos:  position{line: 180, col: 1, offset: 4906},
-			expr: &seqExpr{
-				pos: position{line: 180, col: 17, offset: 4922},
-				exprs: []any{
-					&ruleRefExpr{
-						pos:  position{line: 180, col: 17, offset: 4922},
-						name: "Identifier",
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 180, col: 28, offset: 4933},
-						expr: &ruleRefExpr{
-							pos:  position{line: 180, col: 28, offset: 4933},
+			name: "CreateSequence",
+			pos:  position{line: 151, col: 1, offset: 5540},
+			expr: &actionExpr{
+				pos: position{line: 151, col: 19, offset: 5558},
+				run: (*parser).callonCreateSequence1,
+				expr: &seqExpr{
+					pos: position{line: 151, col: 19, offset: 5558},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 151, col: 19, offset: 5558},
+							val:        "CREATE",
+							ignoreCase: false,
+							want:       "\"CREATE\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 151, col: 28, offset: 5567},
 							name: "WhiteSpace",
 						},
-					},
-					&litMatcher{
-						pos:        position{line: 180, col: 40, offset: 4945},
-						val:        "(",
-						ignoreCase: false,
-						want:       "\"(\"",
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 180, col: 44, offset: 4949},
-						expr: &ruleRefExpr{
-							pos:  position{line: 180, col: 44, offset: 4949},
-							name: "FunctionArgs",
+						&litMatcher{
+							pos:        position{line: 151, col: 39, offset: 5578},
+							val:        "SEQUENCE",
+							ignoreCase: false,
+							want:       "\"SEQUENCE\"",
 						},
-					},
-					&litMatcher{
-						pos:        position{line: 180, col: 58, offset: 4963},
-						val:        ")",
-						ignoreCase: false,
-						want:       "\")\"",
-					},
-				},
-			},
-		},
-		{
-			name: "FunctionArgs",
-			pos:  position{line: 181, col: 1, offset: 4968},
-			expr: &zeroOrOneExpr{
-				pos: position{line: 181, col: 17, offset: 4984},
-				expr: &seqExpr{
-					pos: position{line: 181, col: 18, offset: 4985},
-					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 181, col: 18, offset: 4985},
-							name: "FunctionArg",
+							pos:  position{line: 151, col: 50, offset: 5589},
+							name: "WhiteSpace",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 181, col: 30, offset: 4997},
-							expr: &seqExpr{
-								pos: position{line: 181, col: 31, offset: 4998},
-								exprs: []any{
-									&zeroOrOneExpr{
-										pos: position{line: 181, col: 31, offset: 4998},
-										expr: &ruleRefExpr{
-											pos:  position{line: 181, col: 31, offset: 4998},
-											name: "WhiteSpace",
+						&labeledExpr{
+							pos:   position{line: 151, col: 61, offset: 5600},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 151, col: 66, offset: 5605},
+								name: "TableName",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 151, col: 76, offset: 5615},
+							label: "opts",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 151, col: 81, offset: 5620},
+								expr: &seqExpr{
+									pos: position{line: 151, col: 82, offset: 5621},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 151, col: 82, offset: 5621},
+											expr: &ruleRefExpr{
+												pos:  position{line: 151, col: 82, offset: 5621},
+												name: "WhiteSpace",
+											},
 										},
-									},
-									&litMatcher{
-										pos:        position{line: 181, col: 43, offset: 5010},
-										val:        ",",
-										ignoreCase: false,
-										want:       "\",\"",
-									},
-									&zeroOrOneExpr{
-										pos: position{line: 181, col: 47, offset: 5014},
-										expr: &ruleRefExpr{
-											pos:  position{line: 181, col: 47, offset: 5014},
-											name: "WhiteSpace",
+										&ruleRefExpr{
+											pos:  position{line: 151, col: 94, offset: 5633},
+											name: "SequenceOption",
 										},
 									},
-									&ruleRefExpr{
-										pos:  position{line: 181, col: 59, offset: 5026},
-										name: "FunctionArg",
-									},
 								},
 							},
 						},
+						&zeroOrOneExpr{
+							pos: position{line: 151, col: 111, offset: 5650},
+							expr: &ruleRefExpr{
+								pos:  position{line: 151, col: 111, offset: 5650},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 151, col: 123, offset: 5662},
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "FunctionArg",
-			pos:  position{line: 182, col: 1, offset: 5043},
+			name: "SequenceOption",
+			pos:  position{line: 161, col: 1, offset: 5922},
 			expr: &choiceExpr{
-				pos: position{line: 182, col: 16, offset: 5058},
+				pos: position{line: 161, col: 19, offset: 5940},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 182, col: 16, offset: 5058},
-						name: "FunctionCall",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 182, col: 31, offset: 5073},
-						name: "LiteralValue",
+						pos:  position{line: 161, col: 19, offset: 5940},
+						name: "SequenceValueOption",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 182, col: 46, offset: 5088},
-						name: "Identifier",
+						pos:  position{line: 161, col: 41, offset: 5962},
+						name: "SequenceFlag",
 					},
-					&oneOrMoreExpr{
-						pos: position{line: 182, col: 59, offset: 5101},
-						expr: &seqExpr{
-							pos: position{line: 182, col: 60, offset: 5102},
-							exprs: []any{
-								&notExpr{
-									pos: position{line: 182, col: 60, offset: 5102},
-									expr: &charClassMatcher{
-										pos:        position{line: 182, col: 61, offset: 5103},
-										val:        "[(),]",
-										chars:      []rune{'(', ')', ','},
-										ignoreCase: false,
-										inverted:   false,
-									},
-								},
-								&anyMatcher{
-									line: 182, col: 67, offset: 5109,
-								},
+				},
+			},
+		},
+		{
+			name: "SequenceValueOption",
+			pos:  position{line: 163, col: 1, offset: 5978},
+			expr: &actionExpr{
+				pos: position{line: 163, col: 24, offset: 6001},
+				run: (*parser).callonSequenceValueOption1,
+				expr: &seqExpr{
+					pos: position{line: 163, col: 24, offset: 6001},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 163, col: 24, offset: 6001},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 163, col: 29, offset: 6006},
+								name: "SequenceValueKeyword",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 163, col: 50, offset: 6027},
+							expr: &ruleRefExpr{
+								pos:  position{line: 163, col: 50, offset: 6027},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 163, col: 62, offset: 6039},
+							label: "val",
+							expr: &ruleRefExpr{
+								pos:  position{line: 163, col: 66, offset: 6043},
+								name: "SequenceNumber",
 							},
 						},
 					},
@@ -1241,758 +1470,929 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ColumnType",
-			pos:  position{line: 184, col: 1, offset: 5116},
+			name: "SequenceValueKeyword",
+			pos:  position{line: 167, col: 1, offset: 6119},
 			expr: &actionExpr{
-				pos: position{line: 184, col: 15, offset: 5130},
-				run: (*parser).callonColumnType1,
+				pos: position{line: 167, col: 25, offset: 6143},
+				run: (*parser).callonSequenceValueKeyword1,
 				expr: &choiceExpr{
-					pos: position{line: 184, col: 16, offset: 5131},
+					pos: position{line: 167, col: 26, offset: 6144},
 					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 184, col: 16, offset: 5131},
-							val:        "CHAR",
-							ignoreCase: false,
-							want:       "\"CHAR\"",
+						&seqExpr{
+							pos: position{line: 167, col: 26, offset: 6144},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 167, col: 26, offset: 6144},
+									val:        "INCREMENT",
+									ignoreCase: false,
+									want:       "\"INCREMENT\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 167, col: 38, offset: 6156},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 167, col: 49, offset: 6167},
+									val:        "BY",
+									ignoreCase: false,
+									want:       "\"BY\"",
+								},
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 184, col: 25, offset: 5140},
-							val:        "BLOB",
-							ignoreCase: false,
-							want:       "\"BLOB\"",
+						&seqExpr{
+							pos: position{line: 167, col: 56, offset: 6174},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 167, col: 56, offset: 6174},
+									val:        "START",
+									ignoreCase: false,
+									want:       "\"START\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 167, col: 64, offset: 6182},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 167, col: 75, offset: 6193},
+									val:        "WITH",
+									ignoreCase: false,
+									want:       "\"WITH\"",
+								},
+							},
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 34, offset: 5149},
-							val:        "CLOB",
+							pos:        position{line: 167, col: 84, offset: 6202},
+							val:        "MINVALUE",
 							ignoreCase: false,
-							want:       "\"CLOB\"",
+							want:       "\"MINVALUE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 43, offset: 5158},
-							val:        "DATE",
+							pos:        position{line: 167, col: 97, offset: 6215},
+							val:        "MAXVALUE",
 							ignoreCase: false,
-							want:       "\"DATE\"",
+							want:       "\"MAXVALUE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 52, offset: 5167},
-							val:        "DECIMAL",
+							pos:        position{line: 167, col: 110, offset: 6228},
+							val:        "CACHE",
 							ignoreCase: false,
-							want:       "\"DECIMAL\"",
+							want:       "\"CACHE\"",
 						},
-						&litMatcher{
-							pos:        position{line: 184, col: 64, offset: 5179},
-							val:        "INT",
+					},
+				},
+			},
+		},
+		{
+			name: "SequenceNumber",
+			pos:  position{line: 172, col: 1, offset: 6364},
+			expr: &actionExpr{
+				pos: position{line: 172, col: 19, offset: 6382},
+				run: (*parser).callonSequenceNumber1,
+				expr: &seqExpr{
+					pos: position{line: 172, col: 19, offset: 6382},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 172, col: 19, offset: 6382},
+							expr: &ruleRefExpr{
+								pos:  position{line: 172, col: 19, offset: 6382},
+								name: "Sign",
+							},
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 172, col: 25, offset: 6388},
+							expr: &charClassMatcher{
+								pos:        position{line: 172, col: 25, offset: 6388},
+								val:        "[0-9]",
+								ranges:     []rune{'0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SequenceFlag",
+			pos:  position{line: 176, col: 1, offset: 6433},
+			expr: &actionExpr{
+				pos: position{line: 176, col: 17, offset: 6449},
+				run: (*parser).callonSequenceFlag1,
+				expr: &choiceExpr{
+					pos: position{line: 176, col: 18, offset: 6450},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 176, col: 18, offset: 6450},
+							val:        "NOMINVALUE",
 							ignoreCase: false,
-							want:       "\"INT\"",
+							want:       "\"NOMINVALUE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 72, offset: 5187},
-							val:        "LONG",
+							pos:        position{line: 176, col: 33, offset: 6465},
+							val:        "NOMAXVALUE",
 							ignoreCase: false,
-							want:       "\"LONG\"",
+							want:       "\"NOMAXVALUE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 81, offset: 5196},
-							val:        "NUMBER",
+							pos:        position{line: 176, col: 48, offset: 6480},
+							val:        "NOCACHE",
 							ignoreCase: false,
-							want:       "\"NUMBER\"",
+							want:       "\"NOCACHE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 92, offset: 5207},
-							val:        "NUMERICAL",
+							pos:        position{line: 176, col: 60, offset: 6492},
+							val:        "NOCYCLE",
 							ignoreCase: false,
-							want:       "\"NUMERICAL\"",
+							want:       "\"NOCYCLE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 106, offset: 5221},
-							val:        "RAW",
+							pos:        position{line: 176, col: 72, offset: 6504},
+							val:        "CYCLE",
 							ignoreCase: false,
-							want:       "\"RAW\"",
+							want:       "\"CYCLE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 114, offset: 5229},
-							val:        "TIMESTAMP",
+							pos:        position{line: 176, col: 82, offset: 6514},
+							val:        "NOORDER",
 							ignoreCase: false,
-							want:       "\"TIMESTAMP\"",
+							want:       "\"NOORDER\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 128, offset: 5243},
-							val:        "UROWID",
+							pos:        position{line: 176, col: 94, offset: 6526},
+							val:        "ORDER",
 							ignoreCase: false,
-							want:       "\"UROWID\"",
+							want:       "\"ORDER\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 139, offset: 5254},
-							val:        "VARCHAR2",
+							pos:        position{line: 176, col: 104, offset: 6536},
+							val:        "NOKEEP",
 							ignoreCase: false,
-							want:       "\"VARCHAR2\"",
+							want:       "\"NOKEEP\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 152, offset: 5267},
-							val:        "VARCHAR",
+							pos:        position{line: 176, col: 115, offset: 6547},
+							val:        "KEEP",
 							ignoreCase: false,
-							want:       "\"VARCHAR\"",
+							want:       "\"KEEP\"",
+						},
+						&litMatcher{
+							pos:        position{line: 176, col: 124, offset: 6556},
+							val:        "NOSCALE",
+							ignoreCase: false,
+							want:       "\"NOSCALE\"",
+						},
+						&seqExpr{
+							pos: position{line: 176, col: 136, offset: 6568},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 176, col: 136, offset: 6568},
+									val:        "SCALE",
+									ignoreCase: false,
+									want:       "\"SCALE\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 176, col: 144, offset: 6576},
+									expr: &seqExpr{
+										pos: position{line: 176, col: 145, offset: 6577},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 176, col: 145, offset: 6577},
+												name: "WhiteSpace",
+											},
+											&choiceExpr{
+												pos: position{line: 176, col: 157, offset: 6589},
+												alternatives: []any{
+													&litMatcher{
+														pos:        position{line: 176, col: 157, offset: 6589},
+														val:        "NOEXTEND",
+														ignoreCase: false,
+														want:       "\"NOEXTEND\"",
+													},
+													&litMatcher{
+														pos:        position{line: 176, col: 170, offset: 6602},
+														val:        "EXTEND",
+														ignoreCase: false,
+														want:       "\"EXTEND\"",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 176, col: 184, offset: 6616},
+							val:        "NOSHARD",
+							ignoreCase: false,
+							want:       "\"NOSHARD\"",
+						},
+						&seqExpr{
+							pos: position{line: 176, col: 196, offset: 6628},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 176, col: 196, offset: 6628},
+									val:        "SHARD",
+									ignoreCase: false,
+									want:       "\"SHARD\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 176, col: 204, offset: 6636},
+									expr: &seqExpr{
+										pos: position{line: 176, col: 205, offset: 6637},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 176, col: 205, offset: 6637},
+												name: "WhiteSpace",
+											},
+											&choiceExpr{
+												pos: position{line: 176, col: 217, offset: 6649},
+												alternatives: []any{
+													&litMatcher{
+														pos:        position{line: 176, col: 217, offset: 6649},
+														val:        "NOEXTEND",
+														ignoreCase: false,
+														want:       "\"NOEXTEND\"",
+													},
+													&litMatcher{
+														pos:        position{line: 176, col: 230, offset: 6662},
+														val:        "EXTEND",
+														ignoreCase: false,
+														want:       "\"EXTEND\"",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 176, col: 244, offset: 6676},
+							val:        "SESSION",
+							ignoreCase: false,
+							want:       "\"SESSION\"",
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 164, offset: 5279},
-							val:        "\"SYS\".\"XMLTYPE\"",
+							pos:        position{line: 176, col: 256, offset: 6688},
+							val:        "GLOBAL",
 							ignoreCase: false,
-							want:       "\"\\\"SYS\\\".\\\"XMLTYPE\\\"\"",
+							want:       "\"GLOBAL\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ColumnTypeArgs",
-			pos:  position{line: 188, col: 1, offset: 5340},
+			name: "AlterTable",
+			pos:  position{line: 180, col: 1, offset: 6785},
 			expr: &actionExpr{
-				pos: position{line: 188, col: 19, offset: 5358},
-				run: (*parser).callonColumnTypeArgs1,
+				pos: position{line: 180, col: 15, offset: 6799},
+				run: (*parser).callonAlterTable1,
 				expr: &seqExpr{
-					pos: position{line: 188, col: 19, offset: 5358},
+					pos: position{line: 180, col: 15, offset: 6799},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 188, col: 19, offset: 5358},
-							val:        "(",
+							pos:        position{line: 180, col: 15, offset: 6799},
+							val:        "ALTER",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\"ALTER\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 180, col: 23, offset: 6807},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 180, col: 34, offset: 6818},
+							val:        "TABLE",
+							ignoreCase: false,
+							want:       "\"TABLE\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 180, col: 42, offset: 6826},
+							name: "WhiteSpace",
 						},
 						&labeledExpr{
-							pos:   position{line: 188, col: 23, offset: 5362},
-							label: "args",
+							pos:   position{line: 180, col: 53, offset: 6837},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 180, col: 58, offset: 6842},
+								name: "TableName",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 180, col: 68, offset: 6852},
+							label: "items",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 188, col: 28, offset: 5367},
-								expr: &ruleRefExpr{
-									pos:  position{line: 188, col: 28, offset: 5367},
-									name: "ColumnTypeArg",
+								pos: position{line: 180, col: 74, offset: 6858},
+								expr: &seqExpr{
+									pos: position{line: 180, col: 75, offset: 6859},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 180, col: 75, offset: 6859},
+											expr: &ruleRefExpr{
+												pos:  position{line: 180, col: 75, offset: 6859},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 180, col: 87, offset: 6871},
+											name: "AlterTableAction",
+										},
+									},
 								},
 							},
 						},
+						&zeroOrOneExpr{
+							pos: position{line: 180, col: 106, offset: 6890},
+							expr: &ruleRefExpr{
+								pos:  position{line: 180, col: 106, offset: 6890},
+								name: "WhiteSpace",
+							},
+						},
 						&litMatcher{
-							pos:        position{line: 188, col: 43, offset: 5382},
-							val:        ")",
+							pos:        position{line: 180, col: 118, offset: 6902},
+							val:        ";",
 							ignoreCase: false,
-							want:       "\")\"",
+							want:       "\";\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ColumnTypeArg",
-			pos:  position{line: 196, col: 1, offset: 5560},
+			name: "AlterTableAction",
+			pos:  position{line: 191, col: 1, offset: 7185},
 			expr: &actionExpr{
-				pos: position{line: 196, col: 18, offset: 5577},
-				run: (*parser).callonColumnTypeArg1,
+				pos: position{line: 191, col: 21, offset: 7205},
+				run: (*parser).callonAlterTableAction1,
+				expr: &labeledExpr{
+					pos:   position{line: 191, col: 21, offset: 7205},
+					label: "actions",
+					expr: &choiceExpr{
+						pos: position{line: 191, col: 30, offset: 7214},
+						alternatives: []any{
+							&ruleRefExpr{
+								pos:  position{line: 191, col: 30, offset: 7214},
+								name: "AlterAddConstraint",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 191, col: 51, offset: 7235},
+								name: "AlterAddList",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 191, col: 66, offset: 7250},
+								name: "AlterAddColumn",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 191, col: 83, offset: 7267},
+								name: "AlterModifyConstraint",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 191, col: 107, offset: 7291},
+								name: "AlterModifyList",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 191, col: 125, offset: 7309},
+								name: "AlterModifyColumn",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 191, col: 145, offset: 7329},
+								name: "AlterDropConstraint",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AlterAddConstraint",
+			pos:  position{line: 198, col: 1, offset: 7488},
+			expr: &actionExpr{
+				pos: position{line: 198, col: 23, offset: 7510},
+				run: (*parser).callonAlterAddConstraint1,
 				expr: &seqExpr{
-					pos: position{line: 196, col: 18, offset: 5577},
+					pos: position{line: 198, col: 23, offset: 7510},
 					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 198, col: 23, offset: 7510},
+							val:        "ADD",
+							ignoreCase: false,
+							want:       "\"ADD\"",
+						},
 						&zeroOrOneExpr{
-							pos: position{line: 196, col: 18, offset: 5577},
+							pos: position{line: 198, col: 29, offset: 7516},
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 18, offset: 5577},
+								pos:  position{line: 198, col: 29, offset: 7516},
 								name: "WhiteSpace",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 196, col: 30, offset: 5589},
-							label: "num",
-							expr: &choiceExpr{
-								pos: position{line: 196, col: 35, offset: 5594},
-								alternatives: []any{
-									&ruleRefExpr{
-										pos:  position{line: 196, col: 35, offset: 5594},
-										name: "Digits",
-									},
-									&litMatcher{
-										pos:        position{line: 196, col: 42, offset: 5601},
-										val:        "*",
-										ignoreCase: false,
-										want:       "\"*\"",
-									},
-								},
+							pos:   position{line: 198, col: 41, offset: 7528},
+							label: "con",
+							expr: &ruleRefExpr{
+								pos:  position{line: 198, col: 45, offset: 7532},
+								name: "TableConstraint",
 							},
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "AlterAddList",
+			pos:  position{line: 203, col: 1, offset: 7713},
+			expr: &actionExpr{
+				pos: position{line: 203, col: 17, offset: 7729},
+				run: (*parser).callonAlterAddList1,
+				expr: &seqExpr{
+					pos: position{line: 203, col: 17, offset: 7729},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 203, col: 17, offset: 7729},
+							val:        "ADD",
+							ignoreCase: false,
+							want:       "\"ADD\"",
+						},
 						&zeroOrOneExpr{
-							pos: position{line: 196, col: 47, offset: 5606},
+							pos: position{line: 203, col: 23, offset: 7735},
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 47, offset: 5606},
+								pos:  position{line: 203, col: 23, offset: 7735},
 								name: "WhiteSpace",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 196, col: 59, offset: 5618},
-							label: "numType",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 196, col: 67, offset: 5626},
-								expr: &ruleRefExpr{
-									pos:  position{line: 196, col: 67, offset: 5626},
-									name: "ColumnTypeKeyword",
-								},
-							},
+						&litMatcher{
+							pos:        position{line: 203, col: 35, offset: 7747},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 196, col: 86, offset: 5645},
+							pos: position{line: 203, col: 39, offset: 7751},
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 86, offset: 5645},
+								pos:  position{line: 203, col: 39, offset: 7751},
 								name: "WhiteSpace",
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 196, col: 98, offset: 5657},
-							expr: &litMatcher{
-								pos:        position{line: 196, col: 98, offset: 5657},
-								val:        ",",
-								ignoreCase: false,
-								want:       "\",\"",
+						&labeledExpr{
+							pos:   position{line: 203, col: 51, offset: 7763},
+							label: "elems",
+							expr: &ruleRefExpr{
+								pos:  position{line: 203, col: 57, offset: 7769},
+								name: "TableElements",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 196, col: 103, offset: 5662},
+							pos: position{line: 203, col: 71, offset: 7783},
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 103, offset: 5662},
+								pos:  position{line: 203, col: 71, offset: 7783},
 								name: "WhiteSpace",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 203, col: 83, offset: 7795},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ColumnTypeKeyword",
-			pos:  position{line: 211, col: 1, offset: 5916},
+			name: "AlterAddColumn",
+			pos:  position{line: 215, col: 1, offset: 8199},
 			expr: &actionExpr{
-				pos: position{line: 211, col: 22, offset: 5937},
-				run: (*parser).callonColumnTypeKeyword1,
-				expr: &choiceExpr{
-					pos: position{line: 211, col: 23, offset: 5938},
-					alternatives: []any{
+				pos: position{line: 215, col: 19, offset: 8217},
+				run: (*parser).callonAlterAddColumn1,
+				expr: &seqExpr{
+					pos: position{line: 215, col: 19, offset: 8217},
+					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 211, col: 23, offset: 5938},
-							val:        "BYTE",
+							pos:        position{line: 215, col: 19, offset: 8217},
+							val:        "ADD",
 							ignoreCase: false,
-							want:       "\"BYTE\"",
+							want:       "\"ADD\"",
 						},
-						&litMatcher{
-							pos:        position{line: 211, col: 32, offset: 5947},
-							val:        "CHAR",
-							ignoreCase: false,
-							want:       "\"CHAR\"",
+						&ruleRefExpr{
+							pos:  position{line: 215, col: 25, offset: 8223},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 215, col: 36, offset: 8234},
+							label: "col",
+							expr: &choiceExpr{
+								pos: position{line: 215, col: 41, offset: 8239},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 215, col: 41, offset: 8239},
+										name: "VirtualColumn",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 215, col: 57, offset: 8255},
+										name: "Column",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "IgnoreTableEndParams",
-			pos:  position{line: 215, col: 1, offset: 5993},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 215, col: 25, offset: 6017},
+			name: "AlterModifyConstraint",
+			pos:  position{line: 219, col: 1, offset: 8377},
+			expr: &actionExpr{
+				pos: position{line: 219, col: 26, offset: 8402},
+				run: (*parser).callonAlterModifyConstraint1,
 				expr: &seqExpr{
-					pos: position{line: 215, col: 26, offset: 6018},
+					pos: position{line: 219, col: 26, offset: 8402},
 					exprs: []any{
-						&notExpr{
-							pos: position{line: 215, col: 26, offset: 6018},
-							expr: &litMatcher{
-								pos:        position{line: 215, col: 27, offset: 6019},
-								val:        ";",
-								ignoreCase: false,
-								want:       "\";\"",
-							},
+						&litMatcher{
+							pos:        position{line: 219, col: 26, offset: 8402},
+							val:        "MODIFY",
+							ignoreCase: false,
+							want:       "\"MODIFY\"",
 						},
-						&anyMatcher{
-							line: 215, col: 31, offset: 6023,
+						&ruleRefExpr{
+							pos:  position{line: 219, col: 35, offset: 8411},
+							name: "WhiteSpace",
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "ColumnName",
-			pos:  position{line: 222, col: 1, offset: 6114},
-			expr: &ruleRefExpr{
-				pos:  position{line: 222, col: 15, offset: 6128},
-				name: "LiteralString",
-			},
-		},
-		{
-			name: "Identifier",
-			pos:  position{line: 224, col: 1, offset: 6145},
-			expr: &seqExpr{
-				pos: position{line: 224, col: 15, offset: 6159},
-				exprs: []any{
-					&charClassMatcher{
-						pos:        position{line: 224, col: 15, offset: 6159},
-						val:        "[a-zA-Z_]",
-						chars:      []rune{'_'},
-						ranges:     []rune{'a', 'z', 'A', 'Z'},
-						ignoreCase: false,
-						inverted:   false,
-					},
-					&oneOrMoreExpr{
-						pos: position{line: 224, col: 24, offset: 6168},
-						expr: &charClassMatcher{
-							pos:        position{line: 224, col: 24, offset: 6168},
-							val:        "[a-zA-Z0-9_]",
-							chars:      []rune{'_'},
-							ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+						&litMatcher{
+							pos:        position{line: 219, col: 46, offset: 8422},
+							val:        "CONSTRAINT",
 							ignoreCase: false,
-							inverted:   false,
+							want:       "\"CONSTRAINT\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 219, col: 59, offset: 8435},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 219, col: 70, offset: 8446},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 219, col: 75, offset: 8451},
+								name: "TableNamePart",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 219, col: 89, offset: 8465},
+							label: "items",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 219, col: 95, offset: 8471},
+								expr: &seqExpr{
+									pos: position{line: 219, col: 96, offset: 8472},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 219, col: 96, offset: 8472},
+											expr: &ruleRefExpr{
+												pos:  position{line: 219, col: 96, offset: 8472},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 219, col: 108, offset: 8484},
+											name: "ConstraintStateItem",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "LiteralValue",
-			pos:  position{line: 226, col: 1, offset: 6185},
-			expr: &choiceExpr{
-				pos: position{line: 226, col: 17, offset: 6201},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 226, col: 17, offset: 6201},
-						name: "LiteralString",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 226, col: 33, offset: 6217},
-						name: "LiteralNumber",
-					},
-				},
-			},
-		},
-		{
-			name: "LiteralNumber",
-			pos:  position{line: 228, col: 1, offset: 6234},
+			name: "AlterModifyList",
+			pos:  position{line: 231, col: 1, offset: 8897},
 			expr: &actionExpr{
-				pos: position{line: 228, col: 18, offset: 6251},
-				run: (*parser).callonLiteralNumber1,
+				pos: position{line: 231, col: 20, offset: 8916},
+				run: (*parser).callonAlterModifyList1,
 				expr: &seqExpr{
-					pos: position{line: 228, col: 18, offset: 6251},
+					pos: position{line: 231, col: 20, offset: 8916},
 					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 231, col: 20, offset: 8916},
+							val:        "MODIFY",
+							ignoreCase: false,
+							want:       "\"MODIFY\"",
+						},
 						&zeroOrOneExpr{
-							pos: position{line: 228, col: 18, offset: 6251},
+							pos: position{line: 231, col: 29, offset: 8925},
 							expr: &ruleRefExpr{
-								pos:  position{line: 228, col: 18, offset: 6251},
-								name: "Sign",
+								pos:  position{line: 231, col: 29, offset: 8925},
+								name: "WhiteSpace",
 							},
 						},
-						&choiceExpr{
-							pos: position{line: 228, col: 25, offset: 6258},
-							alternatives: []any{
-								&ruleRefExpr{
-									pos:  position{line: 228, col: 25, offset: 6258},
-									name: "Float",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 228, col: 33, offset: 6266},
-									name: "Integer",
-								},
-							},
+						&litMatcher{
+							pos:        position{line: 231, col: 41, offset: 8937},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "Sign",
-			pos:  position{line: 231, col: 1, offset: 6311},
-			expr: &charClassMatcher{
-				pos:        position{line: 231, col: 9, offset: 6319},
-				val:        "[+-]",
-				chars:      []rune{'+', '-'},
-				ignoreCase: false,
-				inverted:   false,
-			},
-		},
-		{
-			name: "Float",
-			pos:  position{line: 232, col: 1, offset: 6325},
-			expr: &choiceExpr{
-				pos: position{line: 232, col: 10, offset: 6334},
-				alternatives: []any{
-					&seqExpr{
-						pos: position{line: 232, col: 10, offset: 6334},
-						exprs: []any{
-							&zeroOrOneExpr{
-								pos: position{line: 232, col: 10, offset: 6334},
-								expr: &ruleRefExpr{
-									pos:  position{line: 232, col: 10, offset: 6334},
-									name: "Digits",
-								},
-							},
-							&litMatcher{
-								pos:        position{line: 232, col: 18, offset: 6342},
-								val:        ".",
-								ignoreCase: false,
-								want:       "\".\"",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 232, col: 22, offset: 6346},
-								name: "Digits",
-							},
-							&zeroOrOneExpr{
-								pos: position{line: 232, col: 29, offset: 6353},
-								expr: &ruleRefExpr{
-									pos:  position{line: 232, col: 30, offset: 6354},
-									name: "ExponentPart",
-								},
+						&zeroOrOneExpr{
+							pos: position{line: 231, col: 45, offset: 8941},
+							expr: &ruleRefExpr{
+								pos:  position{line: 231, col: 45, offset: 8941},
+								name: "WhiteSpace",
 							},
 						},
-					},
-					&seqExpr{
-						pos: position{line: 232, col: 47, offset: 6371},
-						exprs: []any{
-							&ruleRefExpr{
-								pos:  position{line: 232, col: 47, offset: 6371},
-								name: "Digits",
-							},
-							&litMatcher{
-								pos:        position{line: 232, col: 54, offset: 6378},
-								val:        ".",
-								ignoreCase: false,
-								want:       "\".\"",
+						&labeledExpr{
+							pos:   position{line: 231, col: 57, offset: 8953},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 231, col: 63, offset: 8959},
+								name: "ModifyColumn",
 							},
-							&zeroOrOneExpr{
-								pos: position{line: 232, col: 58, offset: 6382},
-								expr: &ruleRefExpr{
-									pos:  position{line: 232, col: 59, offset: 6383},
-									name: "ExponentPart",
+						},
+						&labeledExpr{
+							pos:   position{line: 231, col: 76, offset: 8972},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 231, col: 81, offset: 8977},
+								expr: &seqExpr{
+									pos: position{line: 231, col: 82, offset: 8978},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 231, col: 82, offset: 8978},
+											expr: &ruleRefExpr{
+												pos:  position{line: 231, col: 82, offset: 8978},
+												name: "WhiteSpace",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 231, col: 94, offset: 8990},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 231, col: 98, offset: 8994},
+											expr: &ruleRefExpr{
+												pos:  position{line: 231, col: 98, offset: 8994},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 231, col: 110, offset: 9006},
+											name: "ModifyColumn",
+										},
+									},
 								},
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "Integer",
-			pos:  position{line: 233, col: 1, offset: 6399},
-			expr: &seqExpr{
-				pos: position{line: 233, col: 12, offset: 6410},
-				exprs: []any{
-					&ruleRefExpr{
-						pos:  position{line: 233, col: 12, offset: 6410},
-						name: "Digits",
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 233, col: 19, offset: 6417},
-						expr: &ruleRefExpr{
-							pos:  position{line: 233, col: 20, offset: 6418},
-							name: "ExponentPart",
+						&zeroOrOneExpr{
+							pos: position{line: 231, col: 125, offset: 9021},
+							expr: &ruleRefExpr{
+								pos:  position{line: 231, col: 125, offset: 9021},
+								name: "WhiteSpace",
+							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "ExponentPart",
-			pos:  position{line: 234, col: 1, offset: 6434},
-			expr: &seqExpr{
-				pos: position{line: 234, col: 17, offset: 6450},
-				exprs: []any{
-					&charClassMatcher{
-						pos:        position{line: 234, col: 17, offset: 6450},
-						val:        "[eE]",
-						chars:      []rune{'e', 'E'},
-						ignoreCase: false,
-						inverted:   false,
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 234, col: 22, offset: 6455},
-						expr: &charClassMatcher{
-							pos:        position{line: 234, col: 22, offset: 6455},
-							val:        "[+-]",
-							chars:      []rune{'+', '-'},
+						&litMatcher{
+							pos:        position{line: 231, col: 137, offset: 9033},
+							val:        ")",
 							ignoreCase: false,
-							inverted:   false,
+							want:       "\")\"",
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 234, col: 28, offset: 6461},
-						name: "Digits",
-					},
-				},
-			},
-		},
-		{
-			name: "Digits",
-			pos:  position{line: 235, col: 1, offset: 6469},
-			expr: &actionExpr{
-				pos: position{line: 235, col: 11, offset: 6479},
-				run: (*parser).callonDigits1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 235, col: 11, offset: 6479},
-					expr: &charClassMatcher{
-						pos:        position{line: 235, col: 11, offset: 6479},
-						val:        "[0-9]",
-						ranges:     []rune{'0', '9'},
-						ignoreCase: false,
-						inverted:   false,
-					},
-				},
-			},
-		},
-		{
-			name: "LiteralString",
-			pos:  position{line: 244, col: 1, offset: 6627},
-			expr: &choiceExpr{
-				pos: position{line: 244, col: 18, offset: 6644},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 244, col: 18, offset: 6644},
-						name: "LiteralStringSingleQuote",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 244, col: 45, offset: 6671},
-						name: "LiteralStringDoubleQuote",
-					},
 				},
 			},
 		},
 		{
-			name: "LiteralStringSingleQuote",
-			pos:  position{line: 245, col: 1, offset: 6697},
+			name: "AlterModifyColumn",
+			pos:  position{line: 239, col: 1, offset: 9244},
 			expr: &actionExpr{
-				pos: position{line: 245, col: 29, offset: 6725},
-				run: (*parser).callonLiteralStringSingleQuote1,
+				pos: position{line: 239, col: 22, offset: 9265},
+				run: (*parser).callonAlterModifyColumn1,
 				expr: &seqExpr{
-					pos: position{line: 245, col: 29, offset: 6725},
+					pos: position{line: 239, col: 22, offset: 9265},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 245, col: 29, offset: 6725},
-							val:        "'",
+							pos:        position{line: 239, col: 22, offset: 9265},
+							val:        "MODIFY",
 							ignoreCase: false,
-							want:       "\"'\"",
+							want:       "\"MODIFY\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 245, col: 35, offset: 6731},
-							expr: &choiceExpr{
-								pos: position{line: 245, col: 36, offset: 6732},
-								alternatives: []any{
-									&litMatcher{
-										pos:        position{line: 245, col: 36, offset: 6732},
-										val:        "''",
-										ignoreCase: false,
-										want:       "\"''\"",
-									},
-									&seqExpr{
-										pos: position{line: 245, col: 43, offset: 6739},
-										exprs: []any{
-											&notExpr{
-												pos: position{line: 245, col: 43, offset: 6739},
-												expr: &litMatcher{
-													pos:        position{line: 245, col: 44, offset: 6740},
-													val:        "'",
-													ignoreCase: false,
-													want:       "\"'\"",
-												},
-											},
-											&anyMatcher{
-												line: 245, col: 49, offset: 6745,
-											},
-										},
-									},
-								},
-							},
+						&ruleRefExpr{
+							pos:  position{line: 239, col: 31, offset: 9274},
+							name: "WhiteSpace",
 						},
-						&litMatcher{
-							pos:        position{line: 245, col: 54, offset: 6750},
-							val:        "'",
-							ignoreCase: false,
-							want:       "\"'\"",
+						&labeledExpr{
+							pos:   position{line: 239, col: 42, offset: 9285},
+							label: "col",
+							expr: &ruleRefExpr{
+								pos:  position{line: 239, col: 46, offset: 9289},
+								name: "ModifyColumn",
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "LiteralStringDoubleQuote",
-			pos:  position{line: 253, col: 1, offset: 6963},
+			name: "ModifyColumn",
+			pos:  position{line: 244, col: 1, offset: 9450},
 			expr: &actionExpr{
-				pos: position{line: 253, col: 29, offset: 6991},
-				run: (*parser).callonLiteralStringDoubleQuote1,
+				pos: position{line: 244, col: 17, offset: 9466},
+				run: (*parser).callonModifyColumn1,
 				expr: &seqExpr{
-					pos: position{line: 253, col: 29, offset: 6991},
+					pos: position{line: 244, col: 17, offset: 9466},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 253, col: 29, offset: 6991},
-							val:        "\"",
-							ignoreCase: false,
-							want:       "\"\\\"\"",
+						&labeledExpr{
+							pos:   position{line: 244, col: 17, offset: 9466},
+							label: "colname",
+							expr: &ruleRefExpr{
+								pos:  position{line: 244, col: 25, offset: 9474},
+								name: "ColumnName",
+							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 253, col: 33, offset: 6995},
-							expr: &seqExpr{
-								pos: position{line: 253, col: 34, offset: 6996},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 253, col: 34, offset: 6996},
-										expr: &litMatcher{
-											pos:        position{line: 253, col: 35, offset: 6997},
-											val:        "\"",
-											ignoreCase: false,
-											want:       "\"\\\"\"",
+						&labeledExpr{
+							pos:   position{line: 244, col: 36, offset: 9485},
+							label: "coltype",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 244, col: 44, offset: 9493},
+								expr: &seqExpr{
+									pos: position{line: 244, col: 45, offset: 9494},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 244, col: 45, offset: 9494},
+											expr: &ruleRefExpr{
+												pos:  position{line: 244, col: 45, offset: 9494},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 244, col: 57, offset: 9506},
+											name: "ColumnType",
 										},
-									},
-									&anyMatcher{
-										line: 253, col: 39, offset: 7001,
 									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 253, col: 43, offset: 7005},
-							val:        "\"",
-							ignoreCase: false,
-							want:       "\"\\\"\"",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "WhiteSpace",
-			pos:  position{line: 258, col: 1, offset: 7084},
-			expr: &oneOrMoreExpr{
-				pos: position{line: 258, col: 15, offset: 7098},
-				expr: &choiceExpr{
-					pos: position{line: 258, col: 16, offset: 7099},
-					alternatives: []any{
-						&ruleRefExpr{
-							pos:  position{line: 258, col: 16, offset: 7099},
-							name: "Spaces",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 258, col: 25, offset: 7108},
-							name: "NewLines",
+						&labeledExpr{
+							pos:   position{line: 244, col: 70, offset: 9519},
+							label: "ident",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 244, col: 76, offset: 9525},
+								expr: &seqExpr{
+									pos: position{line: 244, col: 77, offset: 9526},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 244, col: 77, offset: 9526},
+											expr: &ruleRefExpr{
+												pos:  position{line: 244, col: 77, offset: 9526},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 244, col: 89, offset: 9538},
+											name: "ColumnIdentity",
+										},
+									},
+								},
+							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 258, col: 36, offset: 7119},
-							name: "LineComment",
+						&labeledExpr{
+							pos:   position{line: 244, col: 106, offset: 9555},
+							label: "defVal",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 244, col: 113, offset: 9562},
+								expr: &seqExpr{
+									pos: position{line: 244, col: 114, offset: 9563},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 244, col: 114, offset: 9563},
+											expr: &ruleRefExpr{
+												pos:  position{line: 244, col: 114, offset: 9563},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 244, col: 126, offset: 9575},
+											name: "ColumnDefault",
+										},
+									},
+								},
+							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 258, col: 50, offset: 7133},
-							name: "BlockComment",
+						&labeledExpr{
+							pos:   position{line: 244, col: 142, offset: 9591},
+							label: "cons",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 244, col: 147, offset: 9596},
+								expr: &seqExpr{
+									pos: position{line: 244, col: 148, offset: 9597},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 244, col: 148, offset: 9597},
+											expr: &ruleRefExpr{
+												pos:  position{line: 244, col: 148, offset: 9597},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 244, col: 160, offset: 9609},
+											name: "ColumnConstraints",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "Spaces",
-			pos:  position{line: 259, col: 1, offset: 7149},
-			expr: &actionExpr{
-				pos: position{line: 259, col: 11, offset: 7159},
-				run: (*parser).callonSpaces1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 259, col: 11, offset: 7159},
-					expr: &ruleRefExpr{
-						pos:  position{line: 259, col: 11, offset: 7159},
-						name: "Space",
-					},
-				},
-			},
-		},
-		{
-			name: "Space",
-			pos:  position{line: 262, col: 1, offset: 7191},
-			expr: &charClassMatcher{
-				pos:        position{line: 262, col: 10, offset: 7200},
-				val:        "[ \\t]",
-				chars:      []rune{' ', '\t'},
-				ignoreCase: false,
-				inverted:   false,
-			},
-		},
-		{
-			name: "NewLines",
-			pos:  position{line: 263, col: 1, offset: 7207},
-			expr: &actionExpr{
-				pos: position{line: 263, col: 13, offset: 7219},
-				run: (*parser).callonNewLines1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 263, col: 13, offset: 7219},
-					expr: &ruleRefExpr{
-						pos:  position{line: 263, col: 13, offset: 7219},
-						name: "NewLine",
-					},
-				},
-			},
-		},
-		{
-			name: "NewLine",
-			pos:  position{line: 266, col: 1, offset: 7253},
-			expr: &charClassMatcher{
-				pos:        position{line: 266, col: 12, offset: 7264},
-				val:        "[ \\r\\n]",
-				chars:      []rune{' ', '\r', '\n'},
-				ignoreCase: false,
-				inverted:   false,
-			},
-		},
-		{
-			name: "LineComment",
-			pos:  position{line: 267, col: 1, offset: 7273},
+			name: "AlterDropConstraint",
+			pos:  position{line: 264, col: 1, offset: 10210},
 			expr: &actionExpr{
-				pos: position{line: 267, col: 16, offset: 7288},
-				run: (*parser).callonLineComment1,
+				pos: position{line: 264, col: 24, offset: 10233},
+				run: (*parser).callonAlterDropConstraint1,
 				expr: &seqExpr{
-					pos: position{line: 267, col: 16, offset: 7288},
+					pos: position{line: 264, col: 24, offset: 10233},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 267, col: 16, offset: 7288},
-							val:        "--",
+							pos:        position{line: 264, col: 24, offset: 10233},
+							val:        "DROP",
 							ignoreCase: false,
-							want:       "\"--\"",
+							want:       "\"DROP\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 267, col: 21, offset: 7293},
+						&ruleRefExpr{
+							pos:  position{line: 264, col: 31, offset: 10240},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 264, col: 42, offset: 10251},
+							label: "target",
+							expr: &choiceExpr{
+								pos: position{line: 264, col: 50, offset: 10259},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 264, col: 50, offset: 10259},
+										name: "DropNamedConstraint",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 264, col: 72, offset: 10281},
+										name: "DropPrimaryKey",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 264, col: 88, offset: 10297},
 							expr: &seqExpr{
-								pos: position{line: 267, col: 22, offset: 7294},
+								pos: position{line: 264, col: 89, offset: 10298},
 								exprs: []any{
-									&notExpr{
-										pos: position{line: 267, col: 22, offset: 7294},
-										expr: &charClassMatcher{
-											pos:        position{line: 267, col: 23, offset: 7295},
-											val:        "[\\r\\n]",
-											chars:      []rune{'\r', '\n'},
-											ignoreCase: false,
-											inverted:   false,
-										},
+									&ruleRefExpr{
+										pos:  position{line: 264, col: 89, offset: 10298},
+										name: "WhiteSpace",
 									},
-									&anyMatcher{
-										line: 267, col: 30, offset: 7302,
+									&litMatcher{
+										pos:        position{line: 264, col: 100, offset: 10309},
+										val:        "CASCADE",
+										ignoreCase: false,
+										want:       "\"CASCADE\"",
 									},
 								},
 							},
 						},
-						&choiceExpr{
-							pos: position{line: 267, col: 35, offset: 7307},
-							alternatives: []any{
-								&seqExpr{
-									pos: position{line: 267, col: 35, offset: 7307},
-									exprs: []any{
-										&zeroOrOneExpr{
-											pos: position{line: 267, col: 35, offset: 7307},
-											expr: &litMatcher{
-												pos:        position{line: 267, col: 35, offset: 7307},
-												val:        "\r",
+						&zeroOrOneExpr{
+							pos: position{line: 264, col: 112, offset: 10321},
+							expr: &seqExpr{
+								pos: position{line: 264, col: 113, offset: 10322},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 264, col: 113, offset: 10322},
+										name: "WhiteSpace",
+									},
+									&choiceExpr{
+										pos: position{line: 264, col: 125, offset: 10334},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 264, col: 125, offset: 10334},
+												val:        "KEEP",
 												ignoreCase: false,
-												want:       "\"\\r\"",
+												want:       "\"KEEP\"",
+											},
+											&litMatcher{
+												pos:        position{line: 264, col: 134, offset: 10343},
+												val:        "DROP",
+												ignoreCase: false,
+												want:       "\"DROP\"",
 											},
-										},
-										&litMatcher{
-											pos:        position{line: 267, col: 41, offset: 7313},
-											val:        "\n",
-											ignoreCase: false,
-											want:       "\"\\n\"",
 										},
 									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 267, col: 48, offset: 7320},
-									name: "EOF",
+									&ruleRefExpr{
+										pos:  position{line: 264, col: 142, offset: 10351},
+										name: "WhiteSpace",
+									},
+									&litMatcher{
+										pos:        position{line: 264, col: 153, offset: 10362},
+										val:        "INDEX",
+										ignoreCase: false,
+										want:       "\"INDEX\"",
+									},
 								},
 							},
 						},
@@ -2001,421 +2401,11155 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "BlockComment",
-			pos:  position{line: 270, col: 1, offset: 7349},
+			name: "DropNamedConstraint",
+			pos:  position{line: 267, col: 1, offset: 10500},
 			expr: &actionExpr{
-				pos: position{line: 270, col: 17, offset: 7365},
-				run: (*parser).callonBlockComment1,
+				pos: position{line: 267, col: 24, offset: 10523},
+				run: (*parser).callonDropNamedConstraint1,
 				expr: &seqExpr{
-					pos: position{line: 270, col: 17, offset: 7365},
+					pos: position{line: 267, col: 24, offset: 10523},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 270, col: 17, offset: 7365},
-							val:        "/*",
+							pos:        position{line: 267, col: 24, offset: 10523},
+							val:        "CONSTRAINT",
 							ignoreCase: false,
-							want:       "\"/*\"",
+							want:       "\"CONSTRAINT\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 270, col: 22, offset: 7370},
-							expr: &seqExpr{
-								pos: position{line: 270, col: 23, offset: 7371},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 270, col: 23, offset: 7371},
-										expr: &litMatcher{
-											pos:        position{line: 270, col: 24, offset: 7372},
-											val:        "*/",
-											ignoreCase: false,
-											want:       "\"*/\"",
-										},
-									},
-									&anyMatcher{
-										line: 270, col: 29, offset: 7377,
-									},
-								},
+						&ruleRefExpr{
+							pos:  position{line: 267, col: 37, offset: 10536},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 267, col: 48, offset: 10547},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 267, col: 53, offset: 10552},
+								name: "TableNamePart",
 							},
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "DropPrimaryKey",
+			pos:  position{line: 270, col: 1, offset: 10660},
+			expr: &actionExpr{
+				pos: position{line: 270, col: 19, offset: 10678},
+				run: (*parser).callonDropPrimaryKey1,
+				expr: &seqExpr{
+					pos: position{line: 270, col: 19, offset: 10678},
+					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 270, col: 33, offset: 7381},
-							val:        "*/",
+							pos:        position{line: 270, col: 19, offset: 10678},
+							val:        "PRIMARY",
 							ignoreCase: false,
-							want:       "\"*/\"",
+							want:       "\"PRIMARY\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 270, col: 29, offset: 10688},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 270, col: 40, offset: 10699},
+							val:        "KEY",
+							ignoreCase: false,
+							want:       "\"KEY\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "Include",
-			pos:  position{line: 273, col: 1, offset: 7410},
+			name: "Grant",
+			pos:  position{line: 274, col: 1, offset: 10818},
 			expr: &actionExpr{
-				pos: position{line: 273, col: 12, offset: 7421},
-				run: (*parser).callonInclude1,
+				pos: position{line: 274, col: 10, offset: 10827},
+				run: (*parser).callonGrant1,
 				expr: &seqExpr{
-					pos: position{line: 273, col: 12, offset: 7421},
+					pos: position{line: 274, col: 10, offset: 10827},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 273, col: 12, offset: 7421},
-							val:        "@",
+							pos:        position{line: 274, col: 10, offset: 10827},
+							val:        "GRANT",
 							ignoreCase: false,
-							want:       "\"@\"",
+							want:       "\"GRANT\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 273, col: 16, offset: 7425},
-							expr: &seqExpr{
-								pos: position{line: 273, col: 17, offset: 7426},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 273, col: 17, offset: 7426},
-										expr: &charClassMatcher{
-											pos:        position{line: 273, col: 18, offset: 7427},
-											val:        "[\\r\\n]",
-											chars:      []rune{'\r', '\n'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-									&anyMatcher{
-										line: 273, col: 25, offset: 7434,
-									},
-								},
+						&zeroOrOneExpr{
+							pos: position{line: 274, col: 18, offset: 10835},
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 18, offset: 10835},
+								name: "WhiteSpace",
 							},
 						},
-						&choiceExpr{
-							pos: position{line: 273, col: 30, offset: 7439},
-							alternatives: []any{
-								&seqExpr{
-									pos: position{line: 273, col: 30, offset: 7439},
+						&labeledExpr{
+							pos:   position{line: 274, col: 30, offset: 10847},
+							label: "privs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 36, offset: 10853},
+								name: "PrivilegeList",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 274, col: 50, offset: 10867},
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 50, offset: 10867},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 274, col: 62, offset: 10879},
+							val:        "ON",
+							ignoreCase: false,
+							want:       "\"ON\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 274, col: 67, offset: 10884},
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 67, offset: 10884},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 274, col: 79, offset: 10896},
+							label: "where",
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 85, offset: 10902},
+								name: "TableName",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 274, col: 95, offset: 10912},
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 95, offset: 10912},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 274, col: 107, offset: 10924},
+							val:        "TO",
+							ignoreCase: false,
+							want:       "\"TO\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 274, col: 112, offset: 10929},
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 112, offset: 10929},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 274, col: 124, offset: 10941},
+							label: "who",
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 128, offset: 10945},
+								name: "GranteeList",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 274, col: 140, offset: 10957},
+							label: "opts",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 274, col: 145, offset: 10962},
+								expr: &seqExpr{
+									pos: position{line: 274, col: 146, offset: 10963},
 									exprs: []any{
-										&zeroOrOneExpr{
-											pos: position{line: 273, col: 30, offset: 7439},
-											expr: &litMatcher{
-												pos:        position{line: 273, col: 30, offset: 7439},
-												val:        "\r",
-												ignoreCase: false,
-												want:       "\"\\r\"",
+										&ruleRefExpr{
+											pos:  position{line: 274, col: 146, offset: 10963},
+											name: "WhiteSpace",
+										},
+										&litMatcher{
+											pos:        position{line: 274, col: 157, offset: 10974},
+											val:        "WITH",
+											ignoreCase: false,
+											want:       "\"WITH\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 274, col: 164, offset: 10981},
+											name: "WhiteSpace",
+										},
+										&choiceExpr{
+											pos: position{line: 274, col: 176, offset: 10993},
+											alternatives: []any{
+												&litMatcher{
+													pos:        position{line: 274, col: 176, offset: 10993},
+													val:        "GRANT",
+													ignoreCase: false,
+													want:       "\"GRANT\"",
+												},
+												&litMatcher{
+													pos:        position{line: 274, col: 186, offset: 11003},
+													val:        "HIERARCHY",
+													ignoreCase: false,
+													want:       "\"HIERARCHY\"",
+												},
 											},
 										},
+										&ruleRefExpr{
+											pos:  position{line: 274, col: 199, offset: 11016},
+											name: "WhiteSpace",
+										},
 										&litMatcher{
-											pos:        position{line: 273, col: 36, offset: 7445},
-											val:        "\n",
+											pos:        position{line: 274, col: 210, offset: 11027},
+											val:        "OPTION",
 											ignoreCase: false,
-											want:       "\"\\n\"",
+											want:       "\"OPTION\"",
 										},
 									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 273, col: 43, offset: 7452},
-									name: "EOF",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 274, col: 221, offset: 11038},
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 221, offset: 11038},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 274, col: 233, offset: 11050},
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Revoke",
+			pos:  position{line: 290, col: 1, offset: 11542},
+			expr: &actionExpr{
+				pos: position{line: 290, col: 11, offset: 11552},
+				run: (*parser).callonRevoke1,
+				expr: &seqExpr{
+					pos: position{line: 290, col: 11, offset: 11552},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 290, col: 11, offset: 11552},
+							val:        "REVOKE",
+							ignoreCase: false,
+							want:       "\"REVOKE\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 290, col: 20, offset: 11561},
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 20, offset: 11561},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 290, col: 32, offset: 11573},
+							label: "privs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 38, offset: 11579},
+								name: "PrivilegeList",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 290, col: 52, offset: 11593},
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 52, offset: 11593},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 290, col: 64, offset: 11605},
+							val:        "ON",
+							ignoreCase: false,
+							want:       "\"ON\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 290, col: 69, offset: 11610},
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 69, offset: 11610},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 290, col: 81, offset: 11622},
+							label: "where",
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 87, offset: 11628},
+								name: "TableName",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 290, col: 97, offset: 11638},
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 97, offset: 11638},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 290, col: 109, offset: 11650},
+							val:        "FROM",
+							ignoreCase: false,
+							want:       "\"FROM\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 290, col: 116, offset: 11657},
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 116, offset: 11657},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 290, col: 128, offset: 11669},
+							label: "who",
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 132, offset: 11673},
+								name: "GranteeList",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 290, col: 144, offset: 11685},
+							expr: &seqExpr{
+								pos: position{line: 290, col: 145, offset: 11686},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 290, col: 145, offset: 11686},
+										name: "WhiteSpace",
+									},
+									&choiceExpr{
+										pos: position{line: 290, col: 157, offset: 11698},
+										alternatives: []any{
+											&seqExpr{
+												pos: position{line: 290, col: 157, offset: 11698},
+												exprs: []any{
+													&litMatcher{
+														pos:        position{line: 290, col: 157, offset: 11698},
+														val:        "CASCADE",
+														ignoreCase: false,
+														want:       "\"CASCADE\"",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 290, col: 167, offset: 11708},
+														name: "WhiteSpace",
+													},
+													&litMatcher{
+														pos:        position{line: 290, col: 178, offset: 11719},
+														val:        "CONSTRAINTS",
+														ignoreCase: false,
+														want:       "\"CONSTRAINTS\"",
+													},
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 290, col: 194, offset: 11735},
+												val:        "FORCE",
+												ignoreCase: false,
+												want:       "\"FORCE\"",
+											},
+										},
+									},
 								},
 							},
 						},
+						&zeroOrOneExpr{
+							pos: position{line: 290, col: 205, offset: 11746},
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 205, offset: 11746},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 290, col: 217, offset: 11758},
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "EOF",
-			pos:  position{line: 277, col: 1, offset: 7483},
-			expr: &notExpr{
-				pos: position{line: 277, col: 8, offset: 7490},
-				expr: &anyMatcher{
-					line: 277, col: 9, offset: 7491,
+			name: "PrivilegeList",
+			pos:  position{line: 301, col: 1, offset: 12003},
+			expr: &actionExpr{
+				pos: position{line: 301, col: 18, offset: 12020},
+				run: (*parser).callonPrivilegeList1,
+				expr: &seqExpr{
+					pos: position{line: 301, col: 18, offset: 12020},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 301, col: 18, offset: 12020},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 301, col: 24, offset: 12026},
+								name: "Privilege",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 301, col: 34, offset: 12036},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 301, col: 39, offset: 12041},
+								expr: &seqExpr{
+									pos: position{line: 301, col: 40, offset: 12042},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 301, col: 40, offset: 12042},
+											expr: &ruleRefExpr{
+												pos:  position{line: 301, col: 40, offset: 12042},
+												name: "WhiteSpace",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 301, col: 52, offset: 12054},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 301, col: 56, offset: 12058},
+											expr: &ruleRefExpr{
+												pos:  position{line: 301, col: 56, offset: 12058},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 301, col: 68, offset: 12070},
+											name: "Privilege",
+										},
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
-	},
-}
-
-func (c *current) onFile1(stmts any) (any, error) {
-
-	var res []any
-	//for each pair in stmts
-	for _, stmt := range stmts.([]any) {
-		pair := stmt.([]any)
-		// pair[1] is WhiteSpace and we don't care about it
-		if pair[0] != nil {
-			res = append(res, pair[0])
-		}
+		{
+			name: "Privilege",
+			pos:  position{line: 308, col: 1, offset: 12278},
+			expr: &actionExpr{
+				pos: position{line: 308, col: 14, offset: 12291},
+				run: (*parser).callonPrivilege1,
+				expr: &seqExpr{
+					pos: position{line: 308, col: 14, offset: 12291},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 308, col: 14, offset: 12291},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 308, col: 19, offset: 12296},
+								name: "PrivilegeName",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 308, col: 33, offset: 12310},
+							label: "cols",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 308, col: 38, offset: 12315},
+								expr: &seqExpr{
+									pos: position{line: 308, col: 39, offset: 12316},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 308, col: 39, offset: 12316},
+											expr: &ruleRefExpr{
+												pos:  position{line: 308, col: 39, offset: 12316},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 308, col: 51, offset: 12328},
+											name: "ColumnList",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PrivilegeName",
+			pos:  position{line: 315, col: 1, offset: 12495},
+			expr: &actionExpr{
+				pos: position{line: 315, col: 18, offset: 12512},
+				run: (*parser).callonPrivilegeName1,
+				expr: &choiceExpr{
+					pos: position{line: 315, col: 19, offset: 12513},
+					alternatives: []any{
+						&seqExpr{
+							pos: position{line: 315, col: 19, offset: 12513},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 315, col: 19, offset: 12513},
+									val:        "ALL",
+									ignoreCase: false,
+									want:       "\"ALL\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 315, col: 25, offset: 12519},
+									expr: &seqExpr{
+										pos: position{line: 315, col: 26, offset: 12520},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 315, col: 26, offset: 12520},
+												name: "WhiteSpace",
+											},
+											&litMatcher{
+												pos:        position{line: 315, col: 37, offset: 12531},
+												val:        "PRIVILEGES",
+												ignoreCase: false,
+												want:       "\"PRIVILEGES\"",
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 54, offset: 12548},
+							val:        "SELECT",
+							ignoreCase: false,
+							want:       "\"SELECT\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 65, offset: 12559},
+							val:        "INSERT",
+							ignoreCase: false,
+							want:       "\"INSERT\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 76, offset: 12570},
+							val:        "UPDATE",
+							ignoreCase: false,
+							want:       "\"UPDATE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 87, offset: 12581},
+							val:        "DELETE",
+							ignoreCase: false,
+							want:       "\"DELETE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 98, offset: 12592},
+							val:        "REFERENCES",
+							ignoreCase: false,
+							want:       "\"REFERENCES\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 113, offset: 12607},
+							val:        "ALTER",
+							ignoreCase: false,
+							want:       "\"ALTER\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 123, offset: 12617},
+							val:        "INDEX",
+							ignoreCase: false,
+							want:       "\"INDEX\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 133, offset: 12627},
+							val:        "EXECUTE",
+							ignoreCase: false,
+							want:       "\"EXECUTE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 145, offset: 12639},
+							val:        "READ",
+							ignoreCase: false,
+							want:       "\"READ\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 154, offset: 12648},
+							val:        "WRITE",
+							ignoreCase: false,
+							want:       "\"WRITE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 164, offset: 12658},
+							val:        "DEBUG",
+							ignoreCase: false,
+							want:       "\"DEBUG\"",
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 174, offset: 12668},
+							val:        "FLASHBACK",
+							ignoreCase: false,
+							want:       "\"FLASHBACK\"",
+						},
+						&seqExpr{
+							pos: position{line: 315, col: 188, offset: 12682},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 315, col: 188, offset: 12682},
+									val:        "ON",
+									ignoreCase: false,
+									want:       "\"ON\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 315, col: 193, offset: 12687},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 315, col: 204, offset: 12698},
+									val:        "COMMIT",
+									ignoreCase: false,
+									want:       "\"COMMIT\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 315, col: 213, offset: 12707},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 315, col: 224, offset: 12718},
+									val:        "REFRESH",
+									ignoreCase: false,
+									want:       "\"REFRESH\"",
+								},
+							},
+						},
+						&seqExpr{
+							pos: position{line: 315, col: 236, offset: 12730},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 315, col: 236, offset: 12730},
+									val:        "QUERY",
+									ignoreCase: false,
+									want:       "\"QUERY\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 315, col: 244, offset: 12738},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 315, col: 255, offset: 12749},
+									val:        "REWRITE",
+									ignoreCase: false,
+									want:       "\"REWRITE\"",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 315, col: 267, offset: 12761},
+							val:        "UNDER",
+							ignoreCase: false,
+							want:       "\"UNDER\"",
+						},
+						&seqExpr{
+							pos: position{line: 315, col: 277, offset: 12771},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 315, col: 277, offset: 12771},
+									val:        "MERGE",
+									ignoreCase: false,
+									want:       "\"MERGE\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 315, col: 285, offset: 12779},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 315, col: 296, offset: 12790},
+									val:        "VIEW",
+									ignoreCase: false,
+									want:       "\"VIEW\"",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "GranteeList",
+			pos:  position{line: 324, col: 1, offset: 12979},
+			expr: &actionExpr{
+				pos: position{line: 324, col: 16, offset: 12994},
+				run: (*parser).callonGranteeList1,
+				expr: &seqExpr{
+					pos: position{line: 324, col: 16, offset: 12994},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 324, col: 16, offset: 12994},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 324, col: 22, offset: 13000},
+								name: "NamePart",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 324, col: 31, offset: 13009},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 324, col: 36, offset: 13014},
+								expr: &seqExpr{
+									pos: position{line: 324, col: 37, offset: 13015},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 324, col: 37, offset: 13015},
+											expr: &ruleRefExpr{
+												pos:  position{line: 324, col: 37, offset: 13015},
+												name: "WhiteSpace",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 324, col: 49, offset: 13027},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 324, col: 53, offset: 13031},
+											expr: &ruleRefExpr{
+												pos:  position{line: 324, col: 53, offset: 13031},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 324, col: 65, offset: 13043},
+											name: "NamePart",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Comment",
+			pos:  position{line: 332, col: 1, offset: 13249},
+			expr: &actionExpr{
+				pos: position{line: 332, col: 12, offset: 13260},
+				run: (*parser).callonComment1,
+				expr: &seqExpr{
+					pos: position{line: 332, col: 12, offset: 13260},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 332, col: 12, offset: 13260},
+							val:        "COMMENT",
+							ignoreCase: false,
+							want:       "\"COMMENT\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 332, col: 22, offset: 13270},
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 22, offset: 13270},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 332, col: 34, offset: 13282},
+							val:        "ON",
+							ignoreCase: false,
+							want:       "\"ON\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 332, col: 39, offset: 13287},
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 39, offset: 13287},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 332, col: 51, offset: 13299},
+							label: "kind",
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 56, offset: 13304},
+								name: "CommentOnKeyword",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 332, col: 73, offset: 13321},
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 73, offset: 13321},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 332, col: 85, offset: 13333},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 90, offset: 13338},
+								name: "NameParts",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 332, col: 100, offset: 13348},
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 100, offset: 13348},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 332, col: 112, offset: 13360},
+							val:        "IS",
+							ignoreCase: false,
+							want:       "\"IS\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 332, col: 117, offset: 13365},
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 117, offset: 13365},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 332, col: 129, offset: 13377},
+							label: "text",
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 134, offset: 13382},
+								name: "LiteralString",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 332, col: 148, offset: 13396},
+							expr: &ruleRefExpr{
+								pos:  position{line: 332, col: 148, offset: 13396},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 332, col: 160, offset: 13408},
+							val:        ";",
+							ignoreCase: false,
+							want:       "\";\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CommentOnKeyword",
+			pos:  position{line: 348, col: 1, offset: 13908},
+			expr: &choiceExpr{
+				pos: position{line: 348, col: 21, offset: 13928},
+				alternatives: []any{
+					&litMatcher{
+						pos:        position{line: 348, col: 21, offset: 13928},
+						val:        "TABLE",
+						ignoreCase: false,
+						want:       "\"TABLE\"",
+					},
+					&litMatcher{
+						pos:        position{line: 348, col: 31, offset: 13938},
+						val:        "COLUMN",
+						ignoreCase: false,
+						want:       "\"COLUMN\"",
+					},
+				},
+			},
+		},
+		{
+			name: "TableName",
+			pos:  position{line: 350, col: 1, offset: 13950},
+			expr: &actionExpr{
+				pos: position{line: 350, col: 14, offset: 13963},
+				run: (*parser).callonTableName1,
+				expr: &labeledExpr{
+					pos:   position{line: 350, col: 14, offset: 13963},
+					label: "parts",
+					expr: &ruleRefExpr{
+						pos:  position{line: 350, col: 20, offset: 13969},
+						name: "NameParts",
+					},
+				},
+			},
+		},
+		{
+			name: "NameParts",
+			pos:  position{line: 354, col: 1, offset: 14058},
+			expr: &actionExpr{
+				pos: position{line: 354, col: 14, offset: 14071},
+				run: (*parser).callonNameParts1,
+				expr: &seqExpr{
+					pos: position{line: 354, col: 14, offset: 14071},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 354, col: 14, offset: 14071},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 354, col: 20, offset: 14077},
+								name: "NamePart",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 354, col: 29, offset: 14086},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 354, col: 34, offset: 14091},
+								expr: &seqExpr{
+									pos: position{line: 354, col: 35, offset: 14092},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 354, col: 35, offset: 14092},
+											val:        ".",
+											ignoreCase: false,
+											want:       "\".\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 354, col: 39, offset: 14096},
+											name: "NamePart",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NamePart",
+			pos:  position{line: 362, col: 1, offset: 14385},
+			expr: &choiceExpr{
+				pos: position{line: 362, col: 13, offset: 14397},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 362, col: 13, offset: 14397},
+						run: (*parser).callonNamePart2,
+						expr: &labeledExpr{
+							pos:   position{line: 362, col: 13, offset: 14397},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 362, col: 18, offset: 14402},
+								name: "LiteralString",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 364, col: 5, offset: 14490},
+						run: (*parser).callonNamePart5,
+						expr: &ruleRefExpr{
+							pos:  position{line: 364, col: 5, offset: 14490},
+							name: "Identifier",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableNamePart",
+			pos:  position{line: 367, col: 1, offset: 14561},
+			expr: &choiceExpr{
+				pos: position{line: 367, col: 18, offset: 14578},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 367, col: 18, offset: 14578},
+						name: "LiteralString",
+					},
+					&actionExpr{
+						pos: position{line: 367, col: 34, offset: 14594},
+						run: (*parser).callonTableNamePart3,
+						expr: &ruleRefExpr{
+							pos:  position{line: 367, col: 34, offset: 14594},
+							name: "Identifier",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableBody",
+			pos:  position{line: 371, col: 1, offset: 14643},
+			expr: &choiceExpr{
+				pos: position{line: 371, col: 14, offset: 14656},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 371, col: 14, offset: 14656},
+						name: "TableBodyDef",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 371, col: 29, offset: 14671},
+						name: "TableBodySelect",
+					},
+				},
+			},
+		},
+		{
+			name: "TableBodyDef",
+			pos:  position{line: 373, col: 1, offset: 14690},
+			expr: &actionExpr{
+				pos: position{line: 373, col: 17, offset: 14706},
+				run: (*parser).callonTableBodyDef1,
+				expr: &seqExpr{
+					pos: position{line: 373, col: 17, offset: 14706},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 373, col: 17, offset: 14706},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 373, col: 21, offset: 14710},
+							expr: &ruleRefExpr{
+								pos:  position{line: 373, col: 21, offset: 14710},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 373, col: 33, offset: 14722},
+							label: "elems",
+							expr: &ruleRefExpr{
+								pos:  position{line: 373, col: 39, offset: 14728},
+								name: "TableElements",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 373, col: 53, offset: 14742},
+							expr: &ruleRefExpr{
+								pos:  position{line: 373, col: 53, offset: 14742},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 373, col: 65, offset: 14754},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableElements",
+			pos:  position{line: 378, col: 1, offset: 14848},
+			expr: &actionExpr{
+				pos: position{line: 378, col: 18, offset: 14865},
+				run: (*parser).callonTableElements1,
+				expr: &labeledExpr{
+					pos:   position{line: 378, col: 18, offset: 14865},
+					label: "items",
+					expr: &zeroOrMoreExpr{
+						pos: position{line: 378, col: 24, offset: 14871},
+						expr: &seqExpr{
+							pos: position{line: 378, col: 25, offset: 14872},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 378, col: 25, offset: 14872},
+									expr: &ruleRefExpr{
+										pos:  position{line: 378, col: 25, offset: 14872},
+										name: "WhiteSpace",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 378, col: 37, offset: 14884},
+									expr: &litMatcher{
+										pos:        position{line: 378, col: 37, offset: 14884},
+										val:        ",",
+										ignoreCase: false,
+										want:       "\",\"",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 378, col: 42, offset: 14889},
+									expr: &ruleRefExpr{
+										pos:  position{line: 378, col: 42, offset: 14889},
+										name: "WhiteSpace",
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 378, col: 55, offset: 14902},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 378, col: 55, offset: 14902},
+											name: "VirtualColumn",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 378, col: 71, offset: 14918},
+											name: "Column",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 378, col: 80, offset: 14927},
+											name: "TableConstraint",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableConstraint",
+			pos:  position{line: 406, col: 1, offset: 15479},
+			expr: &actionExpr{
+				pos: position{line: 406, col: 20, offset: 15498},
+				run: (*parser).callonTableConstraint1,
+				expr: &seqExpr{
+					pos: position{line: 406, col: 20, offset: 15498},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 406, col: 20, offset: 15498},
+							label: "name",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 406, col: 25, offset: 15503},
+								expr: &ruleRefExpr{
+									pos:  position{line: 406, col: 25, offset: 15503},
+									name: "ConstraintName",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 406, col: 41, offset: 15519},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 406, col: 46, offset: 15524},
+								name: "OutOfLineConstraintBody",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 406, col: 70, offset: 15548},
+							label: "state",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 406, col: 76, offset: 15554},
+								expr: &ruleRefExpr{
+									pos:  position{line: 406, col: 76, offset: 15554},
+									name: "ConstraintState",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OutOfLineConstraintBody",
+			pos:  position{line: 418, col: 1, offset: 15819},
+			expr: &choiceExpr{
+				pos: position{line: 418, col: 28, offset: 15846},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 418, col: 28, offset: 15846},
+						name: "OutOfLinePrimaryKey",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 418, col: 50, offset: 15868},
+						name: "OutOfLineUnique",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 418, col: 68, offset: 15886},
+						name: "OutOfLineForeignKey",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 418, col: 90, offset: 15908},
+						name: "CheckConstraint",
+					},
+				},
+			},
+		},
+		{
+			name: "OutOfLinePrimaryKey",
+			pos:  position{line: 420, col: 1, offset: 15927},
+			expr: &actionExpr{
+				pos: position{line: 420, col: 24, offset: 15950},
+				run: (*parser).callonOutOfLinePrimaryKey1,
+				expr: &seqExpr{
+					pos: position{line: 420, col: 24, offset: 15950},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 420, col: 24, offset: 15950},
+							val:        "PRIMARY",
+							ignoreCase: false,
+							want:       "\"PRIMARY\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 420, col: 34, offset: 15960},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 420, col: 45, offset: 15971},
+							val:        "KEY",
+							ignoreCase: false,
+							want:       "\"KEY\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 420, col: 51, offset: 15977},
+							expr: &ruleRefExpr{
+								pos:  position{line: 420, col: 51, offset: 15977},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 420, col: 63, offset: 15989},
+							label: "cols",
+							expr: &ruleRefExpr{
+								pos:  position{line: 420, col: 68, offset: 15994},
+								name: "ColumnList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OutOfLineUnique",
+			pos:  position{line: 426, col: 1, offset: 16129},
+			expr: &actionExpr{
+				pos: position{line: 426, col: 20, offset: 16148},
+				run: (*parser).callonOutOfLineUnique1,
+				expr: &seqExpr{
+					pos: position{line: 426, col: 20, offset: 16148},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 426, col: 20, offset: 16148},
+							val:        "UNIQUE",
+							ignoreCase: false,
+							want:       "\"UNIQUE\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 426, col: 29, offset: 16157},
+							expr: &ruleRefExpr{
+								pos:  position{line: 426, col: 29, offset: 16157},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 426, col: 41, offset: 16169},
+							label: "cols",
+							expr: &ruleRefExpr{
+								pos:  position{line: 426, col: 46, offset: 16174},
+								name: "ColumnList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OutOfLineForeignKey",
+			pos:  position{line: 432, col: 1, offset: 16304},
+			expr: &actionExpr{
+				pos: position{line: 432, col: 24, offset: 16327},
+				run: (*parser).callonOutOfLineForeignKey1,
+				expr: &seqExpr{
+					pos: position{line: 432, col: 24, offset: 16327},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 432, col: 24, offset: 16327},
+							val:        "FOREIGN",
+							ignoreCase: false,
+							want:       "\"FOREIGN\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 432, col: 34, offset: 16337},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 432, col: 45, offset: 16348},
+							val:        "KEY",
+							ignoreCase: false,
+							want:       "\"KEY\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 432, col: 51, offset: 16354},
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 51, offset: 16354},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 432, col: 63, offset: 16366},
+							label: "cols",
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 68, offset: 16371},
+								name: "ColumnList",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 432, col: 79, offset: 16382},
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 79, offset: 16382},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 432, col: 91, offset: 16394},
+							label: "ref",
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 95, offset: 16398},
+								name: "ReferencesConstraint",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Column",
+			pos:  position{line: 438, col: 1, offset: 16527},
+			expr: &actionExpr{
+				pos: position{line: 438, col: 11, offset: 16537},
+				run: (*parser).callonColumn1,
+				expr: &seqExpr{
+					pos: position{line: 438, col: 11, offset: 16537},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 438, col: 11, offset: 16537},
+							label: "colname",
+							expr: &ruleRefExpr{
+								pos:  position{line: 438, col: 19, offset: 16545},
+								name: "ColumnName",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 438, col: 30, offset: 16556},
+							expr: &ruleRefExpr{
+								pos:  position{line: 438, col: 30, offset: 16556},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 438, col: 42, offset: 16568},
+							label: "coltype",
+							expr: &ruleRefExpr{
+								pos:  position{line: 438, col: 50, offset: 16576},
+								name: "ColumnType",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 438, col: 61, offset: 16587},
+							expr: &ruleRefExpr{
+								pos:  position{line: 438, col: 61, offset: 16587},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 438, col: 73, offset: 16599},
+							label: "ident",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 438, col: 79, offset: 16605},
+								expr: &ruleRefExpr{
+									pos:  position{line: 438, col: 79, offset: 16605},
+									name: "ColumnIdentity",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 438, col: 95, offset: 16621},
+							expr: &ruleRefExpr{
+								pos:  position{line: 438, col: 95, offset: 16621},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 438, col: 107, offset: 16633},
+							label: "defVal",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 438, col: 114, offset: 16640},
+								expr: &ruleRefExpr{
+									pos:  position{line: 438, col: 114, offset: 16640},
+									name: "ColumnDefault",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 438, col: 129, offset: 16655},
+							expr: &ruleRefExpr{
+								pos:  position{line: 438, col: 129, offset: 16655},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 438, col: 141, offset: 16667},
+							label: "cons",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 438, col: 146, offset: 16672},
+								expr: &ruleRefExpr{
+									pos:  position{line: 438, col: 146, offset: 16672},
+									name: "ColumnConstraints",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VirtualColumn",
+			pos:  position{line: 461, col: 1, offset: 17197},
+			expr: &actionExpr{
+				pos: position{line: 461, col: 18, offset: 17214},
+				run: (*parser).callonVirtualColumn1,
+				expr: &seqExpr{
+					pos: position{line: 461, col: 18, offset: 17214},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 461, col: 18, offset: 17214},
+							label: "colname",
+							expr: &ruleRefExpr{
+								pos:  position{line: 461, col: 26, offset: 17222},
+								name: "ColumnName",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 461, col: 37, offset: 17233},
+							label: "coltype",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 461, col: 45, offset: 17241},
+								expr: &seqExpr{
+									pos: position{line: 461, col: 46, offset: 17242},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 461, col: 46, offset: 17242},
+											expr: &ruleRefExpr{
+												pos:  position{line: 461, col: 46, offset: 17242},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 461, col: 58, offset: 17254},
+											name: "ColumnType",
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 461, col: 71, offset: 17267},
+							expr: &ruleRefExpr{
+								pos:  position{line: 461, col: 71, offset: 17267},
+								name: "WhiteSpace",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 461, col: 83, offset: 17279},
+							expr: &seqExpr{
+								pos: position{line: 461, col: 84, offset: 17280},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 461, col: 84, offset: 17280},
+										val:        "GENERATED",
+										ignoreCase: false,
+										want:       "\"GENERATED\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 461, col: 96, offset: 17292},
+										name: "WhiteSpace",
+									},
+									&litMatcher{
+										pos:        position{line: 461, col: 107, offset: 17303},
+										val:        "ALWAYS",
+										ignoreCase: false,
+										want:       "\"ALWAYS\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 461, col: 116, offset: 17312},
+										name: "WhiteSpace",
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 461, col: 129, offset: 17325},
+							val:        "AS",
+							ignoreCase: false,
+							want:       "\"AS\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 461, col: 134, offset: 17330},
+							expr: &ruleRefExpr{
+								pos:  position{line: 461, col: 134, offset: 17330},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 461, col: 146, offset: 17342},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 461, col: 151, offset: 17347},
+								name: "Expression",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 461, col: 162, offset: 17358},
+							expr: &seqExpr{
+								pos: position{line: 461, col: 163, offset: 17359},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 461, col: 163, offset: 17359},
+										name: "WhiteSpace",
+									},
+									&litMatcher{
+										pos:        position{line: 461, col: 174, offset: 17370},
+										val:        "VIRTUAL",
+										ignoreCase: false,
+										want:       "\"VIRTUAL\"",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 461, col: 186, offset: 17382},
+							label: "cons",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 461, col: 191, offset: 17387},
+								expr: &seqExpr{
+									pos: position{line: 461, col: 192, offset: 17388},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 461, col: 192, offset: 17388},
+											expr: &ruleRefExpr{
+												pos:  position{line: 461, col: 192, offset: 17388},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 461, col: 204, offset: 17400},
+											name: "ColumnConstraints",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnIdentity",
+			pos:  position{line: 478, col: 1, offset: 17900},
+			expr: &actionExpr{
+				pos: position{line: 478, col: 19, offset: 17918},
+				run: (*parser).callonColumnIdentity1,
+				expr: &seqExpr{
+					pos: position{line: 478, col: 19, offset: 17918},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 478, col: 19, offset: 17918},
+							val:        "GENERATED",
+							ignoreCase: false,
+							want:       "\"GENERATED\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 478, col: 31, offset: 17930},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 478, col: 42, offset: 17941},
+							label: "kind",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 478, col: 47, offset: 17946},
+								expr: &seqExpr{
+									pos: position{line: 478, col: 48, offset: 17947},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 478, col: 48, offset: 17947},
+											name: "IdentityKind",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 478, col: 61, offset: 17960},
+											name: "WhiteSpace",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 478, col: 74, offset: 17973},
+							val:        "AS",
+							ignoreCase: false,
+							want:       "\"AS\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 478, col: 79, offset: 17978},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 478, col: 90, offset: 17989},
+							val:        "IDENTITY",
+							ignoreCase: false,
+							want:       "\"IDENTITY\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 478, col: 101, offset: 18000},
+							label: "opts",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 478, col: 106, offset: 18005},
+								expr: &ruleRefExpr{
+									pos:  position{line: 478, col: 106, offset: 18005},
+									name: "IdentityOptions",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IdentityKind",
+			pos:  position{line: 488, col: 1, offset: 18262},
+			expr: &actionExpr{
+				pos: position{line: 488, col: 17, offset: 18278},
+				run: (*parser).callonIdentityKind1,
+				expr: &choiceExpr{
+					pos: position{line: 488, col: 18, offset: 18279},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 488, col: 18, offset: 18279},
+							val:        "ALWAYS",
+							ignoreCase: false,
+							want:       "\"ALWAYS\"",
+						},
+						&seqExpr{
+							pos: position{line: 488, col: 29, offset: 18290},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 488, col: 29, offset: 18290},
+									val:        "BY",
+									ignoreCase: false,
+									want:       "\"BY\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 488, col: 34, offset: 18295},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 488, col: 45, offset: 18306},
+									val:        "DEFAULT",
+									ignoreCase: false,
+									want:       "\"DEFAULT\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 488, col: 55, offset: 18316},
+									expr: &seqExpr{
+										pos: position{line: 488, col: 56, offset: 18317},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 488, col: 56, offset: 18317},
+												name: "WhiteSpace",
+											},
+											&litMatcher{
+												pos:        position{line: 488, col: 67, offset: 18328},
+												val:        "ON",
+												ignoreCase: false,
+												want:       "\"ON\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 488, col: 72, offset: 18333},
+												name: "WhiteSpace",
+											},
+											&litMatcher{
+												pos:        position{line: 488, col: 83, offset: 18344},
+												val:        "NULL",
+												ignoreCase: false,
+												want:       "\"NULL\"",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IdentityOptions",
+			pos:  position{line: 491, col: 1, offset: 18425},
+			expr: &choiceExpr{
+				pos: position{line: 491, col: 20, offset: 18444},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 491, col: 20, offset: 18444},
+						run: (*parser).callonIdentityOptions2,
+						expr: &seqExpr{
+							pos: position{line: 491, col: 20, offset: 18444},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 491, col: 20, offset: 18444},
+									expr: &ruleRefExpr{
+										pos:  position{line: 491, col: 20, offset: 18444},
+										name: "WhiteSpace",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 491, col: 32, offset: 18456},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 491, col: 36, offset: 18460},
+									label: "opts",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 491, col: 41, offset: 18465},
+										expr: &seqExpr{
+											pos: position{line: 491, col: 42, offset: 18466},
+											exprs: []any{
+												&zeroOrOneExpr{
+													pos: position{line: 491, col: 42, offset: 18466},
+													expr: &ruleRefExpr{
+														pos:  position{line: 491, col: 42, offset: 18466},
+														name: "WhiteSpace",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 491, col: 54, offset: 18478},
+													name: "SequenceOption",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 491, col: 71, offset: 18495},
+									expr: &ruleRefExpr{
+										pos:  position{line: 491, col: 71, offset: 18495},
+										name: "WhiteSpace",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 491, col: 83, offset: 18507},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 493, col: 5, offset: 18555},
+						run: (*parser).callonIdentityOptions16,
+						expr: &labeledExpr{
+							pos:   position{line: 493, col: 5, offset: 18555},
+							label: "opts",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 493, col: 10, offset: 18560},
+								expr: &seqExpr{
+									pos: position{line: 493, col: 11, offset: 18561},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 493, col: 11, offset: 18561},
+											name: "WhiteSpace",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 493, col: 22, offset: 18572},
+											name: "SequenceOption",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnDefault",
+			pos:  position{line: 498, col: 1, offset: 18636},
+			expr: &actionExpr{
+				pos: position{line: 498, col: 18, offset: 18653},
+				run: (*parser).callonColumnDefault1,
+				expr: &seqExpr{
+					pos: position{line: 498, col: 18, offset: 18653},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 498, col: 18, offset: 18653},
+							val:        "DEFAULT",
+							ignoreCase: false,
+							want:       "\"DEFAULT\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 498, col: 28, offset: 18663},
+							expr: &ruleRefExpr{
+								pos:  position{line: 498, col: 28, offset: 18663},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 498, col: 40, offset: 18675},
+							label: "val",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 498, col: 44, offset: 18679},
+								expr: &ruleRefExpr{
+									pos:  position{line: 498, col: 44, offset: 18679},
+									name: "ColumnDefaultValue",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnDefaultValue",
+			pos:  position{line: 507, col: 1, offset: 18907},
+			expr: &choiceExpr{
+				pos: position{line: 507, col: 23, offset: 18929},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 507, col: 23, offset: 18929},
+						name: "ExpressionTree",
+					},
+					&actionExpr{
+						pos: position{line: 507, col: 40, offset: 18946},
+						run: (*parser).callonColumnDefaultValue3,
+						expr: &choiceExpr{
+							pos: position{line: 507, col: 41, offset: 18947},
+							alternatives: []any{
+								&ruleRefExpr{
+									pos:  position{line: 507, col: 41, offset: 18947},
+									name: "LiteralValue",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 507, col: 56, offset: 18962},
+									name: "ColumnDefaultKeyword",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 507, col: 79, offset: 18985},
+									name: "FunctionCall",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnConstraints",
+			pos:  position{line: 511, col: 1, offset: 19063},
+			expr: &actionExpr{
+				pos: position{line: 511, col: 22, offset: 19084},
+				run: (*parser).callonColumnConstraints1,
+				expr: &labeledExpr{
+					pos:   position{line: 511, col: 22, offset: 19084},
+					label: "items",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 511, col: 28, offset: 19090},
+						expr: &seqExpr{
+							pos: position{line: 511, col: 29, offset: 19091},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 511, col: 29, offset: 19091},
+									expr: &ruleRefExpr{
+										pos:  position{line: 511, col: 29, offset: 19091},
+										name: "WhiteSpace",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 511, col: 41, offset: 19103},
+									name: "ColumnConstraint",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnConstraint",
+			pos:  position{line: 519, col: 1, offset: 19312},
+			expr: &actionExpr{
+				pos: position{line: 519, col: 21, offset: 19332},
+				run: (*parser).callonColumnConstraint1,
+				expr: &seqExpr{
+					pos: position{line: 519, col: 21, offset: 19332},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 519, col: 21, offset: 19332},
+							label: "name",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 519, col: 26, offset: 19337},
+								expr: &ruleRefExpr{
+									pos:  position{line: 519, col: 26, offset: 19337},
+									name: "ConstraintName",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 519, col: 42, offset: 19353},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 519, col: 47, offset: 19358},
+								name: "InlineConstraintBody",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 519, col: 68, offset: 19379},
+							label: "state",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 519, col: 74, offset: 19385},
+								expr: &ruleRefExpr{
+									pos:  position{line: 519, col: 74, offset: 19385},
+									name: "ConstraintState",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConstraintName",
+			pos:  position{line: 531, col: 1, offset: 19650},
+			expr: &actionExpr{
+				pos: position{line: 531, col: 19, offset: 19668},
+				run: (*parser).callonConstraintName1,
+				expr: &seqExpr{
+					pos: position{line: 531, col: 19, offset: 19668},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 531, col: 19, offset: 19668},
+							val:        "CONSTRAINT",
+							ignoreCase: false,
+							want:       "\"CONSTRAINT\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 531, col: 32, offset: 19681},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 531, col: 43, offset: 19692},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 531, col: 48, offset: 19697},
+								name: "TableNamePart",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 531, col: 62, offset: 19711},
+							expr: &ruleRefExpr{
+								pos:  position{line: 531, col: 62, offset: 19711},
+								name: "WhiteSpace",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineConstraintBody",
+			pos:  position{line: 535, col: 1, offset: 19751},
+			expr: &choiceExpr{
+				pos: position{line: 535, col: 25, offset: 19775},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 535, col: 25, offset: 19775},
+						name: "NotNullConstraint",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 535, col: 45, offset: 19795},
+						name: "NullConstraint",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 535, col: 62, offset: 19812},
+						name: "PrimaryKeyConstraint",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 535, col: 85, offset: 19835},
+						name: "UniqueConstraint",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 535, col: 104, offset: 19854},
+						name: "CheckConstraint",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 535, col: 122, offset: 19872},
+						name: "ReferencesConstraint",
+					},
+				},
+			},
+		},
+		{
+			name: "NotNullConstraint",
+			pos:  position{line: 537, col: 1, offset: 19896},
+			expr: &actionExpr{
+				pos: position{line: 537, col: 22, offset: 19917},
+				run: (*parser).callonNotNullConstraint1,
+				expr: &seqExpr{
+					pos: position{line: 537, col: 22, offset: 19917},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 537, col: 22, offset: 19917},
+							val:        "NOT",
+							ignoreCase: false,
+							want:       "\"NOT\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 537, col: 28, offset: 19923},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 537, col: 39, offset: 19934},
+							val:        "NULL",
+							ignoreCase: false,
+							want:       "\"NULL\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NullConstraint",
+			pos:  position{line: 540, col: 1, offset: 20020},
+			expr: &actionExpr{
+				pos: position{line: 540, col: 19, offset: 20038},
+				run: (*parser).callonNullConstraint1,
+				expr: &litMatcher{
+					pos:        position{line: 540, col: 19, offset: 20038},
+					val:        "NULL",
+					ignoreCase: false,
+					want:       "\"NULL\"",
+				},
+			},
+		},
+		{
+			name: "PrimaryKeyConstraint",
+			pos:  position{line: 543, col: 1, offset: 20120},
+			expr: &actionExpr{
+				pos: position{line: 543, col: 25, offset: 20144},
+				run: (*parser).callonPrimaryKeyConstraint1,
+				expr: &seqExpr{
+					pos: position{line: 543, col: 25, offset: 20144},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 543, col: 25, offset: 20144},
+							val:        "PRIMARY",
+							ignoreCase: false,
+							want:       "\"PRIMARY\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 543, col: 35, offset: 20154},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 543, col: 46, offset: 20165},
+							val:        "KEY",
+							ignoreCase: false,
+							want:       "\"KEY\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UniqueConstraint",
+			pos:  position{line: 546, col: 1, offset: 20253},
+			expr: &actionExpr{
+				pos: position{line: 546, col: 21, offset: 20273},
+				run: (*parser).callonUniqueConstraint1,
+				expr: &litMatcher{
+					pos:        position{line: 546, col: 21, offset: 20273},
+					val:        "UNIQUE",
+					ignoreCase: false,
+					want:       "\"UNIQUE\"",
+				},
+			},
+		},
+		{
+			name: "CheckConstraint",
+			pos:  position{line: 549, col: 1, offset: 20359},
+			expr: &actionExpr{
+				pos: position{line: 549, col: 20, offset: 20378},
+				run: (*parser).callonCheckConstraint1,
+				expr: &seqExpr{
+					pos: position{line: 549, col: 20, offset: 20378},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 549, col: 20, offset: 20378},
+							val:        "CHECK",
+							ignoreCase: false,
+							want:       "\"CHECK\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 549, col: 28, offset: 20386},
+							expr: &ruleRefExpr{
+								pos:  position{line: 549, col: 28, offset: 20386},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 549, col: 40, offset: 20398},
+							label: "cond",
+							expr: &ruleRefExpr{
+								pos:  position{line: 549, col: 45, offset: 20403},
+								name: "Expression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ReferencesConstraint",
+			pos:  position{line: 556, col: 1, offset: 20551},
+			expr: &actionExpr{
+				pos: position{line: 556, col: 25, offset: 20575},
+				run: (*parser).callonReferencesConstraint1,
+				expr: &seqExpr{
+					pos: position{line: 556, col: 25, offset: 20575},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 556, col: 25, offset: 20575},
+							val:        "REFERENCES",
+							ignoreCase: false,
+							want:       "\"REFERENCES\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 556, col: 38, offset: 20588},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 556, col: 49, offset: 20599},
+							label: "table",
+							expr: &ruleRefExpr{
+								pos:  position{line: 556, col: 55, offset: 20605},
+								name: "TableName",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 556, col: 65, offset: 20615},
+							expr: &ruleRefExpr{
+								pos:  position{line: 556, col: 65, offset: 20615},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 556, col: 77, offset: 20627},
+							label: "cols",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 556, col: 82, offset: 20632},
+								expr: &ruleRefExpr{
+									pos:  position{line: 556, col: 82, offset: 20632},
+									name: "ColumnList",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 556, col: 94, offset: 20644},
+							label: "rule",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 556, col: 99, offset: 20649},
+								expr: &ruleRefExpr{
+									pos:  position{line: 556, col: 99, offset: 20649},
+									name: "DeleteRule",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DeleteRule",
+			pos:  position{line: 570, col: 1, offset: 20952},
+			expr: &actionExpr{
+				pos: position{line: 570, col: 15, offset: 20966},
+				run: (*parser).callonDeleteRule1,
+				expr: &seqExpr{
+					pos: position{line: 570, col: 15, offset: 20966},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 570, col: 15, offset: 20966},
+							expr: &ruleRefExpr{
+								pos:  position{line: 570, col: 15, offset: 20966},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 570, col: 27, offset: 20978},
+							val:        "ON",
+							ignoreCase: false,
+							want:       "\"ON\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 570, col: 32, offset: 20983},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 570, col: 43, offset: 20994},
+							val:        "DELETE",
+							ignoreCase: false,
+							want:       "\"DELETE\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 570, col: 52, offset: 21003},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 570, col: 63, offset: 21014},
+							label: "rule",
+							expr: &choiceExpr{
+								pos: position{line: 570, col: 69, offset: 21020},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 570, col: 69, offset: 21020},
+										val:        "CASCADE",
+										ignoreCase: false,
+										want:       "\"CASCADE\"",
+									},
+									&seqExpr{
+										pos: position{line: 570, col: 81, offset: 21032},
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 570, col: 81, offset: 21032},
+												val:        "SET",
+												ignoreCase: false,
+												want:       "\"SET\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 570, col: 87, offset: 21038},
+												name: "WhiteSpace",
+											},
+											&litMatcher{
+												pos:        position{line: 570, col: 98, offset: 21049},
+												val:        "NULL",
+												ignoreCase: false,
+												want:       "\"NULL\"",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConstraintState",
+			pos:  position{line: 577, col: 1, offset: 21159},
+			expr: &actionExpr{
+				pos: position{line: 577, col: 20, offset: 21178},
+				run: (*parser).callonConstraintState1,
+				expr: &labeledExpr{
+					pos:   position{line: 577, col: 20, offset: 21178},
+					label: "items",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 577, col: 26, offset: 21184},
+						expr: &seqExpr{
+							pos: position{line: 577, col: 27, offset: 21185},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 577, col: 27, offset: 21185},
+									expr: &ruleRefExpr{
+										pos:  position{line: 577, col: 27, offset: 21185},
+										name: "WhiteSpace",
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 577, col: 40, offset: 21198},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 577, col: 40, offset: 21198},
+											name: "UsingIndex",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 577, col: 53, offset: 21211},
+											name: "ConstraintStateItem",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConstraintStateItem",
+			pos:  position{line: 592, col: 1, offset: 21579},
+			expr: &actionExpr{
+				pos: position{line: 592, col: 24, offset: 21602},
+				run: (*parser).callonConstraintStateItem1,
+				expr: &choiceExpr{
+					pos: position{line: 592, col: 25, offset: 21603},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 592, col: 25, offset: 21603},
+							val:        "ENABLE",
+							ignoreCase: false,
+							want:       "\"ENABLE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 592, col: 36, offset: 21614},
+							val:        "DISABLE",
+							ignoreCase: false,
+							want:       "\"DISABLE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 592, col: 48, offset: 21626},
+							val:        "NOVALIDATE",
+							ignoreCase: false,
+							want:       "\"NOVALIDATE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 592, col: 63, offset: 21641},
+							val:        "VALIDATE",
+							ignoreCase: false,
+							want:       "\"VALIDATE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 592, col: 76, offset: 21654},
+							val:        "NORELY",
+							ignoreCase: false,
+							want:       "\"NORELY\"",
+						},
+						&litMatcher{
+							pos:        position{line: 592, col: 87, offset: 21665},
+							val:        "RELY",
+							ignoreCase: false,
+							want:       "\"RELY\"",
+						},
+						&litMatcher{
+							pos:        position{line: 592, col: 96, offset: 21674},
+							val:        "DEFERRABLE",
+							ignoreCase: false,
+							want:       "\"DEFERRABLE\"",
+						},
+						&seqExpr{
+							pos: position{line: 592, col: 111, offset: 21689},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 592, col: 111, offset: 21689},
+									val:        "NOT",
+									ignoreCase: false,
+									want:       "\"NOT\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 592, col: 117, offset: 21695},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 592, col: 128, offset: 21706},
+									val:        "DEFERRABLE",
+									ignoreCase: false,
+									want:       "\"DEFERRABLE\"",
+								},
+							},
+						},
+						&seqExpr{
+							pos: position{line: 592, col: 143, offset: 21721},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 592, col: 143, offset: 21721},
+									val:        "INITIALLY",
+									ignoreCase: false,
+									want:       "\"INITIALLY\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 592, col: 155, offset: 21733},
+									name: "WhiteSpace",
+								},
+								&choiceExpr{
+									pos: position{line: 592, col: 167, offset: 21745},
+									alternatives: []any{
+										&litMatcher{
+											pos:        position{line: 592, col: 167, offset: 21745},
+											val:        "DEFERRED",
+											ignoreCase: false,
+											want:       "\"DEFERRED\"",
+										},
+										&litMatcher{
+											pos:        position{line: 592, col: 180, offset: 21758},
+											val:        "IMMEDIATE",
+											ignoreCase: false,
+											want:       "\"IMMEDIATE\"",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UsingIndex",
+			pos:  position{line: 596, col: 1, offset: 21845},
+			expr: &actionExpr{
+				pos: position{line: 596, col: 15, offset: 21859},
+				run: (*parser).callonUsingIndex1,
+				expr: &seqExpr{
+					pos: position{line: 596, col: 15, offset: 21859},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 596, col: 15, offset: 21859},
+							val:        "USING",
+							ignoreCase: false,
+							want:       "\"USING\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 596, col: 23, offset: 21867},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 596, col: 34, offset: 21878},
+							val:        "INDEX",
+							ignoreCase: false,
+							want:       "\"INDEX\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 596, col: 42, offset: 21886},
+							label: "target",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 596, col: 49, offset: 21893},
+								expr: &seqExpr{
+									pos: position{line: 596, col: 50, offset: 21894},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 596, col: 50, offset: 21894},
+											expr: &ruleRefExpr{
+												pos:  position{line: 596, col: 50, offset: 21894},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 596, col: 62, offset: 21906},
+											name: "UsingIndexTarget",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 596, col: 81, offset: 21925},
+							label: "opts",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 596, col: 86, offset: 21930},
+								expr: &seqExpr{
+									pos: position{line: 596, col: 87, offset: 21931},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 596, col: 87, offset: 21931},
+											expr: &ruleRefExpr{
+												pos:  position{line: 596, col: 87, offset: 21931},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 596, col: 99, offset: 21943},
+											name: "PhysicalOption",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UsingIndexTarget",
+			pos:  position{line: 613, col: 1, offset: 22415},
+			expr: &choiceExpr{
+				pos: position{line: 613, col: 21, offset: 22435},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 613, col: 21, offset: 22435},
+						run: (*parser).callonUsingIndexTarget2,
+						expr: &labeledExpr{
+							pos:   position{line: 613, col: 21, offset: 22435},
+							label: "stmt",
+							expr: &ruleRefExpr{
+								pos:  position{line: 613, col: 26, offset: 22440},
+								name: "ParenText",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 615, col: 5, offset: 22520},
+						run: (*parser).callonUsingIndexTarget5,
+						expr: &seqExpr{
+							pos: position{line: 615, col: 5, offset: 22520},
+							exprs: []any{
+								&notExpr{
+									pos: position{line: 615, col: 5, offset: 22520},
+									expr: &ruleRefExpr{
+										pos:  position{line: 615, col: 6, offset: 22521},
+										name: "PhysicalOption",
+									},
+								},
+								&notExpr{
+									pos: position{line: 615, col: 21, offset: 22536},
+									expr: &ruleRefExpr{
+										pos:  position{line: 615, col: 22, offset: 22537},
+										name: "ConstraintStateItem",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 615, col: 42, offset: 22557},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 615, col: 47, offset: 22562},
+										name: "TableName",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PhysicalOption",
+			pos:  position{line: 620, col: 1, offset: 22731},
+			expr: &choiceExpr{
+				pos: position{line: 620, col: 19, offset: 22749},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 620, col: 19, offset: 22749},
+						name: "TablespaceOption",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 620, col: 38, offset: 22768},
+						name: "StorageOption",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 620, col: 54, offset: 22784},
+						name: "NumericOption",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 620, col: 70, offset: 22800},
+						name: "FlagOption",
+					},
+				},
+			},
+		},
+		{
+			name: "TablespaceOption",
+			pos:  position{line: 622, col: 1, offset: 22814},
+			expr: &actionExpr{
+				pos: position{line: 622, col: 21, offset: 22834},
+				run: (*parser).callonTablespaceOption1,
+				expr: &seqExpr{
+					pos: position{line: 622, col: 21, offset: 22834},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 622, col: 21, offset: 22834},
+							val:        "TABLESPACE",
+							ignoreCase: false,
+							want:       "\"TABLESPACE\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 622, col: 34, offset: 22847},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 622, col: 45, offset: 22858},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 622, col: 50, offset: 22863},
+								name: "TableNamePart",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StorageOption",
+			pos:  position{line: 625, col: 1, offset: 22963},
+			expr: &actionExpr{
+				pos: position{line: 625, col: 18, offset: 22980},
+				run: (*parser).callonStorageOption1,
+				expr: &seqExpr{
+					pos: position{line: 625, col: 18, offset: 22980},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 625, col: 18, offset: 22980},
+							val:        "STORAGE",
+							ignoreCase: false,
+							want:       "\"STORAGE\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 625, col: 28, offset: 22990},
+							expr: &ruleRefExpr{
+								pos:  position{line: 625, col: 28, offset: 22990},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 625, col: 40, offset: 23002},
+							label: "val",
+							expr: &ruleRefExpr{
+								pos:  position{line: 625, col: 44, offset: 23006},
+								name: "ParenText",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NumericOption",
+			pos:  position{line: 628, col: 1, offset: 23133},
+			expr: &actionExpr{
+				pos: position{line: 628, col: 18, offset: 23150},
+				run: (*parser).callonNumericOption1,
+				expr: &seqExpr{
+					pos: position{line: 628, col: 18, offset: 23150},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 628, col: 18, offset: 23150},
+							label: "name",
+							expr: &choiceExpr{
+								pos: position{line: 628, col: 24, offset: 23156},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 628, col: 24, offset: 23156},
+										val:        "PCTFREE",
+										ignoreCase: false,
+										want:       "\"PCTFREE\"",
+									},
+									&litMatcher{
+										pos:        position{line: 628, col: 36, offset: 23168},
+										val:        "PCTUSED",
+										ignoreCase: false,
+										want:       "\"PCTUSED\"",
+									},
+									&litMatcher{
+										pos:        position{line: 628, col: 48, offset: 23180},
+										val:        "INITRANS",
+										ignoreCase: false,
+										want:       "\"INITRANS\"",
+									},
+									&litMatcher{
+										pos:        position{line: 628, col: 61, offset: 23193},
+										val:        "MAXTRANS",
+										ignoreCase: false,
+										want:       "\"MAXTRANS\"",
+									},
+									&litMatcher{
+										pos:        position{line: 628, col: 74, offset: 23206},
+										val:        "COMPRESS",
+										ignoreCase: false,
+										want:       "\"COMPRESS\"",
+									},
+									&litMatcher{
+										pos:        position{line: 628, col: 87, offset: 23219},
+										val:        "PARALLEL",
+										ignoreCase: false,
+										want:       "\"PARALLEL\"",
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 628, col: 99, offset: 23231},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 628, col: 110, offset: 23242},
+							label: "val",
+							expr: &ruleRefExpr{
+								pos:  position{line: 628, col: 114, offset: 23246},
+								name: "Digits",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FlagOption",
+			pos:  position{line: 631, col: 1, offset: 23359},
+			expr: &actionExpr{
+				pos: position{line: 631, col: 15, offset: 23373},
+				run: (*parser).callonFlagOption1,
+				expr: &choiceExpr{
+					pos: position{line: 631, col: 16, offset: 23374},
+					alternatives: []any{
+						&seqExpr{
+							pos: position{line: 631, col: 16, offset: 23374},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 631, col: 16, offset: 23374},
+									val:        "COMPUTE",
+									ignoreCase: false,
+									want:       "\"COMPUTE\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 631, col: 26, offset: 23384},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 631, col: 37, offset: 23395},
+									val:        "STATISTICS",
+									ignoreCase: false,
+									want:       "\"STATISTICS\"",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 52, offset: 23410},
+							val:        "NOLOGGING",
+							ignoreCase: false,
+							want:       "\"NOLOGGING\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 66, offset: 23424},
+							val:        "LOGGING",
+							ignoreCase: false,
+							want:       "\"LOGGING\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 78, offset: 23436},
+							val:        "NOCOMPRESS",
+							ignoreCase: false,
+							want:       "\"NOCOMPRESS\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 93, offset: 23451},
+							val:        "COMPRESS",
+							ignoreCase: false,
+							want:       "\"COMPRESS\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 106, offset: 23464},
+							val:        "NOPARALLEL",
+							ignoreCase: false,
+							want:       "\"NOPARALLEL\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 121, offset: 23479},
+							val:        "PARALLEL",
+							ignoreCase: false,
+							want:       "\"PARALLEL\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 134, offset: 23492},
+							val:        "REVERSE",
+							ignoreCase: false,
+							want:       "\"REVERSE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 146, offset: 23504},
+							val:        "NOSORT",
+							ignoreCase: false,
+							want:       "\"NOSORT\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 157, offset: 23515},
+							val:        "SORT",
+							ignoreCase: false,
+							want:       "\"SORT\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 166, offset: 23524},
+							val:        "VISIBLE",
+							ignoreCase: false,
+							want:       "\"VISIBLE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 178, offset: 23536},
+							val:        "INVISIBLE",
+							ignoreCase: false,
+							want:       "\"INVISIBLE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 631, col: 192, offset: 23550},
+							val:        "ONLINE",
+							ignoreCase: false,
+							want:       "\"ONLINE\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnList",
+			pos:  position{line: 635, col: 1, offset: 23663},
+			expr: &actionExpr{
+				pos: position{line: 635, col: 15, offset: 23677},
+				run: (*parser).callonColumnList1,
+				expr: &seqExpr{
+					pos: position{line: 635, col: 15, offset: 23677},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 635, col: 15, offset: 23677},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 635, col: 19, offset: 23681},
+							expr: &ruleRefExpr{
+								pos:  position{line: 635, col: 19, offset: 23681},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 635, col: 31, offset: 23693},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 635, col: 37, offset: 23699},
+								name: "TableNamePart",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 635, col: 51, offset: 23713},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 635, col: 56, offset: 23718},
+								expr: &seqExpr{
+									pos: position{line: 635, col: 57, offset: 23719},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 635, col: 57, offset: 23719},
+											expr: &ruleRefExpr{
+												pos:  position{line: 635, col: 57, offset: 23719},
+												name: "WhiteSpace",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 635, col: 69, offset: 23731},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 635, col: 73, offset: 23735},
+											expr: &ruleRefExpr{
+												pos:  position{line: 635, col: 73, offset: 23735},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 635, col: 85, offset: 23747},
+											name: "TableNamePart",
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 635, col: 101, offset: 23763},
+							expr: &ruleRefExpr{
+								pos:  position{line: 635, col: 101, offset: 23763},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 635, col: 113, offset: 23775},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ParenText",
+			pos:  position{line: 644, col: 1, offset: 24012},
+			expr: &actionExpr{
+				pos: position{line: 644, col: 14, offset: 24025},
+				run: (*parser).callonParenText1,
+				expr: &seqExpr{
+					pos: position{line: 644, col: 14, offset: 24025},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 644, col: 14, offset: 24025},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 644, col: 18, offset: 24029},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 644, col: 23, offset: 24034},
+								name: "ParenBody",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 644, col: 33, offset: 24044},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ParenBody",
+			pos:  position{line: 647, col: 1, offset: 24111},
+			expr: &actionExpr{
+				pos: position{line: 647, col: 14, offset: 24124},
+				run: (*parser).callonParenBody1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 647, col: 14, offset: 24124},
+					expr: &choiceExpr{
+						pos: position{line: 647, col: 15, offset: 24125},
+						alternatives: []any{
+							&ruleRefExpr{
+								pos:  position{line: 647, col: 15, offset: 24125},
+								name: "LiteralString",
+							},
+							&seqExpr{
+								pos: position{line: 647, col: 31, offset: 24141},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 647, col: 31, offset: 24141},
+										val:        "(",
+										ignoreCase: false,
+										want:       "\"(\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 647, col: 35, offset: 24145},
+										name: "ParenBody",
+									},
+									&litMatcher{
+										pos:        position{line: 647, col: 45, offset: 24155},
+										val:        ")",
+										ignoreCase: false,
+										want:       "\")\"",
+									},
+								},
+							},
+							&seqExpr{
+								pos: position{line: 647, col: 51, offset: 24161},
+								exprs: []any{
+									&notExpr{
+										pos: position{line: 647, col: 51, offset: 24161},
+										expr: &charClassMatcher{
+											pos:        position{line: 647, col: 52, offset: 24162},
+											val:        "[()'\"]",
+											chars:      []rune{'(', ')', '\'', '"'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+									&anyMatcher{
+										line: 647, col: 59, offset: 24169,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnDefaultKeyword",
+			pos:  position{line: 651, col: 1, offset: 24203},
+			expr: &choiceExpr{
+				pos: position{line: 651, col: 26, offset: 24228},
+				alternatives: []any{
+					&litMatcher{
+						pos:        position{line: 651, col: 26, offset: 24228},
+						val:        "SYSDATE",
+						ignoreCase: false,
+						want:       "\"SYSDATE\"",
+					},
+					&litMatcher{
+						pos:        position{line: 651, col: 38, offset: 24240},
+						val:        "sysdate",
+						ignoreCase: false,
+						want:       "\"sysdate\"",
+					},
+					&litMatcher{
+						pos:        position{line: 651, col: 50, offset: 24252},
+						val:        "localtimestamp",
+						ignoreCase: false,
+						want:       "\"localtimestamp\"",
+					},
+					&litMatcher{
+						pos:        position{line: 651, col: 69, offset: 24271},
+						val:        "systimestamp",
+						ignoreCase: false,
+						want:       "\"systimestamp\"",
+					},
+					&litMatcher{
+						pos:        position{line: 651, col: 86, offset: 24288},
+						val:        "NULL",
+						ignoreCase: false,
+						want:       "\"NULL\"",
+					},
+					&litMatcher{
+						pos:        position{line: 651, col: 95, offset: 24297},
+						val:        "null",
+						ignoreCase: false,
+						want:       "\"null\"",
+					},
+				},
+			},
+		},
+		{
+			name: "FunctionCall",
+			pos:  position{line: 653, col: 1, offset: 24308},
+			expr: &seqExpr{
+				pos: position{line: 653, col: 17, offset: 24324},
+				exprs: []any{
+					&ruleRefExpr{
+						pos:  position{line: 653, col: 17, offset: 24324},
+						name: "Identifier",
+					},
+					&zeroOrOneExpr{
+						pos: position{line: 653, col: 28, offset: 24335},
+						expr: &ruleRefExpr{
+							pos:  position{line: 653, col: 28, offset: 24335},
+							name: "WhiteSpace",
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 653, col: 40, offset: 24347},
+						val:        "(",
+						ignoreCase: false,
+						want:       "\"(\"",
+					},
+					&zeroOrOneExpr{
+						pos: position{line: 653, col: 44, offset: 24351},
+						expr: &ruleRefExpr{
+							pos:  position{line: 653, col: 44, offset: 24351},
+							name: "FunctionArgs",
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 653, col: 58, offset: 24365},
+						val:        ")",
+						ignoreCase: false,
+						want:       "\")\"",
+					},
+				},
+			},
+		},
+		{
+			name: "FunctionArgs",
+			pos:  position{line: 654, col: 1, offset: 24370},
+			expr: &zeroOrOneExpr{
+				pos: position{line: 654, col: 17, offset: 24386},
+				expr: &seqExpr{
+					pos: position{line: 654, col: 18, offset: 24387},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 654, col: 18, offset: 24387},
+							name: "FunctionArg",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 654, col: 30, offset: 24399},
+							expr: &seqExpr{
+								pos: position{line: 654, col: 31, offset: 24400},
+								exprs: []any{
+									&zeroOrOneExpr{
+										pos: position{line: 654, col: 31, offset: 24400},
+										expr: &ruleRefExpr{
+											pos:  position{line: 654, col: 31, offset: 24400},
+											name: "WhiteSpace",
+										},
+									},
+									&litMatcher{
+										pos:        position{line: 654, col: 43, offset: 24412},
+										val:        ",",
+										ignoreCase: false,
+										want:       "\",\"",
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 654, col: 47, offset: 24416},
+										expr: &ruleRefExpr{
+											pos:  position{line: 654, col: 47, offset: 24416},
+											name: "WhiteSpace",
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 654, col: 59, offset: 24428},
+										name: "FunctionArg",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FunctionArg",
+			pos:  position{line: 655, col: 1, offset: 24445},
+			expr: &choiceExpr{
+				pos: position{line: 655, col: 16, offset: 24460},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 655, col: 16, offset: 24460},
+						name: "FunctionCall",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 655, col: 31, offset: 24475},
+						name: "LiteralValue",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 655, col: 46, offset: 24490},
+						name: "Identifier",
+					},
+					&oneOrMoreExpr{
+						pos: position{line: 655, col: 59, offset: 24503},
+						expr: &seqExpr{
+							pos: position{line: 655, col: 60, offset: 24504},
+							exprs: []any{
+								&notExpr{
+									pos: position{line: 655, col: 60, offset: 24504},
+									expr: &charClassMatcher{
+										pos:        position{line: 655, col: 61, offset: 24505},
+										val:        "[(),]",
+										chars:      []rune{'(', ')', ','},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&anyMatcher{
+									line: 655, col: 67, offset: 24511,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Expression",
+			pos:  position{line: 659, col: 1, offset: 24699},
+			expr: &choiceExpr{
+				pos: position{line: 659, col: 15, offset: 24713},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 659, col: 15, offset: 24713},
+						run: (*parser).callonExpression2,
+						expr: &seqExpr{
+							pos: position{line: 659, col: 15, offset: 24713},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 659, col: 15, offset: 24713},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 659, col: 19, offset: 24717},
+									expr: &ruleRefExpr{
+										pos:  position{line: 659, col: 19, offset: 24717},
+										name: "WhiteSpace",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 659, col: 31, offset: 24729},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 659, col: 33, offset: 24731},
+										name: "ExpressionTree",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 659, col: 48, offset: 24746},
+									expr: &ruleRefExpr{
+										pos:  position{line: 659, col: 48, offset: 24746},
+										name: "WhiteSpace",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 659, col: 60, offset: 24758},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 661, col: 5, offset: 24786},
+						run: (*parser).callonExpression12,
+						expr: &labeledExpr{
+							pos:   position{line: 661, col: 5, offset: 24786},
+							label: "text",
+							expr: &ruleRefExpr{
+								pos:  position{line: 661, col: 10, offset: 24791},
+								name: "ParenText",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExpressionTree",
+			pos:  position{line: 664, col: 1, offset: 24862},
+			expr: &actionExpr{
+				pos: position{line: 664, col: 19, offset: 24880},
+				run: (*parser).callonExpressionTree1,
+				expr: &labeledExpr{
+					pos:   position{line: 664, col: 19, offset: 24880},
+					label: "e",
+					expr: &ruleRefExpr{
+						pos:  position{line: 664, col: 21, offset: 24882},
+						name: "Expr",
+					},
+				},
+			},
+		},
+		{
+			name: "Expr",
+			pos:  position{line: 668, col: 1, offset: 24975},
+			expr: &actionExpr{
+				pos: position{line: 668, col: 9, offset: 24983},
+				run: (*parser).callonExpr1,
+				expr: &seqExpr{
+					pos: position{line: 668, col: 9, offset: 24983},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 668, col: 9, offset: 24983},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 668, col: 15, offset: 24989},
+								name: "AndExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 668, col: 23, offset: 24997},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 668, col: 28, offset: 25002},
+								expr: &ruleRefExpr{
+									pos:  position{line: 668, col: 28, offset: 25002},
+									name: "OrRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OrRest",
+			pos:  position{line: 671, col: 1, offset: 25056},
+			expr: &actionExpr{
+				pos: position{line: 671, col: 11, offset: 25066},
+				run: (*parser).callonOrRest1,
+				expr: &seqExpr{
+					pos: position{line: 671, col: 11, offset: 25066},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 671, col: 11, offset: 25066},
+							expr: &ruleRefExpr{
+								pos:  position{line: 671, col: 11, offset: 25066},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 671, col: 23, offset: 25078},
+							val:        "or",
+							ignoreCase: true,
+							want:       "\"OR\"i",
+						},
+						&notExpr{
+							pos: position{line: 671, col: 29, offset: 25084},
+							expr: &ruleRefExpr{
+								pos:  position{line: 671, col: 30, offset: 25085},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 671, col: 45, offset: 25100},
+							expr: &ruleRefExpr{
+								pos:  position{line: 671, col: 45, offset: 25100},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 671, col: 57, offset: 25112},
+							label: "right",
+							expr: &ruleRefExpr{
+								pos:  position{line: 671, col: 63, offset: 25118},
+								name: "AndExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AndExpr",
+			pos:  position{line: 674, col: 1, offset: 25206},
+			expr: &actionExpr{
+				pos: position{line: 674, col: 12, offset: 25217},
+				run: (*parser).callonAndExpr1,
+				expr: &seqExpr{
+					pos: position{line: 674, col: 12, offset: 25217},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 674, col: 12, offset: 25217},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 674, col: 18, offset: 25223},
+								name: "NotExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 674, col: 26, offset: 25231},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 674, col: 31, offset: 25236},
+								expr: &ruleRefExpr{
+									pos:  position{line: 674, col: 31, offset: 25236},
+									name: "AndRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AndRest",
+			pos:  position{line: 677, col: 1, offset: 25291},
+			expr: &actionExpr{
+				pos: position{line: 677, col: 12, offset: 25302},
+				run: (*parser).callonAndRest1,
+				expr: &seqExpr{
+					pos: position{line: 677, col: 12, offset: 25302},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 677, col: 12, offset: 25302},
+							expr: &ruleRefExpr{
+								pos:  position{line: 677, col: 12, offset: 25302},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 677, col: 24, offset: 25314},
+							val:        "and",
+							ignoreCase: true,
+							want:       "\"AND\"i",
+						},
+						&notExpr{
+							pos: position{line: 677, col: 31, offset: 25321},
+							expr: &ruleRefExpr{
+								pos:  position{line: 677, col: 32, offset: 25322},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 677, col: 47, offset: 25337},
+							expr: &ruleRefExpr{
+								pos:  position{line: 677, col: 47, offset: 25337},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 677, col: 59, offset: 25349},
+							label: "right",
+							expr: &ruleRefExpr{
+								pos:  position{line: 677, col: 65, offset: 25355},
+								name: "NotExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NotExpr",
+			pos:  position{line: 680, col: 1, offset: 25444},
+			expr: &choiceExpr{
+				pos: position{line: 680, col: 12, offset: 25455},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 680, col: 12, offset: 25455},
+						run: (*parser).callonNotExpr2,
+						expr: &seqExpr{
+							pos: position{line: 680, col: 12, offset: 25455},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 680, col: 12, offset: 25455},
+									val:        "not",
+									ignoreCase: true,
+									want:       "\"NOT\"i",
+								},
+								&notExpr{
+									pos: position{line: 680, col: 19, offset: 25462},
+									expr: &ruleRefExpr{
+										pos:  position{line: 680, col: 20, offset: 25463},
+										name: "IdentifierChar",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 680, col: 35, offset: 25478},
+									expr: &ruleRefExpr{
+										pos:  position{line: 680, col: 35, offset: 25478},
+										name: "WhiteSpace",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 680, col: 47, offset: 25490},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 680, col: 49, offset: 25492},
+										name: "NotExpr",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 682, col: 5, offset: 25579},
+						name: "Predicate",
+					},
+				},
+			},
+		},
+		{
+			name: "Predicate",
+			pos:  position{line: 685, col: 1, offset: 25674},
+			expr: &actionExpr{
+				pos: position{line: 685, col: 14, offset: 25687},
+				run: (*parser).callonPredicate1,
+				expr: &seqExpr{
+					pos: position{line: 685, col: 14, offset: 25687},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 685, col: 14, offset: 25687},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 685, col: 19, offset: 25692},
+								name: "AddExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 685, col: 27, offset: 25700},
+							label: "tail",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 685, col: 32, offset: 25705},
+								expr: &seqExpr{
+									pos: position{line: 685, col: 33, offset: 25706},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 685, col: 33, offset: 25706},
+											expr: &ruleRefExpr{
+												pos:  position{line: 685, col: 33, offset: 25706},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 685, col: 45, offset: 25718},
+											name: "PredicateTail",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PredicateTail",
+			pos:  position{line: 691, col: 1, offset: 25865},
+			expr: &choiceExpr{
+				pos: position{line: 691, col: 18, offset: 25882},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 691, col: 18, offset: 25882},
+						name: "IsNullTail",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 691, col: 31, offset: 25895},
+						name: "InTail",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 691, col: 40, offset: 25904},
+						name: "BetweenTail",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 691, col: 54, offset: 25918},
+						name: "LikeTail",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 691, col: 65, offset: 25929},
+						name: "CompareTail",
+					},
+				},
+			},
+		},
+		{
+			name: "IsNullTail",
+			pos:  position{line: 692, col: 1, offset: 25942},
+			expr: &actionExpr{
+				pos: position{line: 692, col: 15, offset: 25956},
+				run: (*parser).callonIsNullTail1,
+				expr: &seqExpr{
+					pos: position{line: 692, col: 15, offset: 25956},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 692, col: 15, offset: 25956},
+							val:        "is",
+							ignoreCase: true,
+							want:       "\"IS\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 692, col: 21, offset: 25962},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 692, col: 32, offset: 25973},
+							label: "not",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 692, col: 36, offset: 25977},
+								expr: &seqExpr{
+									pos: position{line: 692, col: 37, offset: 25978},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 692, col: 37, offset: 25978},
+											val:        "not",
+											ignoreCase: true,
+											want:       "\"NOT\"i",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 692, col: 44, offset: 25985},
+											name: "WhiteSpace",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 692, col: 57, offset: 25998},
+							val:        "null",
+							ignoreCase: true,
+							want:       "\"NULL\"i",
+						},
+						&notExpr{
+							pos: position{line: 692, col: 65, offset: 26006},
+							expr: &ruleRefExpr{
+								pos:  position{line: 692, col: 66, offset: 26007},
+								name: "IdentifierChar",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InTail",
+			pos:  position{line: 695, col: 1, offset: 26080},
+			expr: &actionExpr{
+				pos: position{line: 695, col: 11, offset: 26090},
+				run: (*parser).callonInTail1,
+				expr: &seqExpr{
+					pos: position{line: 695, col: 11, offset: 26090},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 695, col: 11, offset: 26090},
+							label: "not",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 695, col: 15, offset: 26094},
+								expr: &seqExpr{
+									pos: position{line: 695, col: 16, offset: 26095},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 695, col: 16, offset: 26095},
+											val:        "not",
+											ignoreCase: true,
+											want:       "\"NOT\"i",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 695, col: 23, offset: 26102},
+											name: "WhiteSpace",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 695, col: 36, offset: 26115},
+							val:        "in",
+							ignoreCase: true,
+							want:       "\"IN\"i",
+						},
+						&notExpr{
+							pos: position{line: 695, col: 42, offset: 26121},
+							expr: &ruleRefExpr{
+								pos:  position{line: 695, col: 43, offset: 26122},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 695, col: 58, offset: 26137},
+							expr: &ruleRefExpr{
+								pos:  position{line: 695, col: 58, offset: 26137},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 695, col: 70, offset: 26149},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 695, col: 74, offset: 26153},
+							expr: &ruleRefExpr{
+								pos:  position{line: 695, col: 74, offset: 26153},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 695, col: 86, offset: 26165},
+							label: "list",
+							expr: &ruleRefExpr{
+								pos:  position{line: 695, col: 91, offset: 26170},
+								name: "ExprList",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 695, col: 100, offset: 26179},
+							expr: &ruleRefExpr{
+								pos:  position{line: 695, col: 100, offset: 26179},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 695, col: 112, offset: 26191},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BetweenTail",
+			pos:  position{line: 698, col: 1, offset: 26278},
+			expr: &actionExpr{
+				pos: position{line: 698, col: 16, offset: 26293},
+				run: (*parser).callonBetweenTail1,
+				expr: &seqExpr{
+					pos: position{line: 698, col: 16, offset: 26293},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 698, col: 16, offset: 26293},
+							label: "not",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 698, col: 20, offset: 26297},
+								expr: &seqExpr{
+									pos: position{line: 698, col: 21, offset: 26298},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 698, col: 21, offset: 26298},
+											val:        "not",
+											ignoreCase: true,
+											want:       "\"NOT\"i",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 698, col: 28, offset: 26305},
+											name: "WhiteSpace",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 698, col: 41, offset: 26318},
+							val:        "between",
+							ignoreCase: true,
+							want:       "\"BETWEEN\"i",
+						},
+						&notExpr{
+							pos: position{line: 698, col: 52, offset: 26329},
+							expr: &ruleRefExpr{
+								pos:  position{line: 698, col: 53, offset: 26330},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 698, col: 68, offset: 26345},
+							expr: &ruleRefExpr{
+								pos:  position{line: 698, col: 68, offset: 26345},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 698, col: 80, offset: 26357},
+							label: "low",
+							expr: &ruleRefExpr{
+								pos:  position{line: 698, col: 84, offset: 26361},
+								name: "AddExpr",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 698, col: 92, offset: 26369},
+							expr: &ruleRefExpr{
+								pos:  position{line: 698, col: 92, offset: 26369},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 698, col: 104, offset: 26381},
+							val:        "and",
+							ignoreCase: true,
+							want:       "\"AND\"i",
+						},
+						&notExpr{
+							pos: position{line: 698, col: 111, offset: 26388},
+							expr: &ruleRefExpr{
+								pos:  position{line: 698, col: 112, offset: 26389},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 698, col: 127, offset: 26404},
+							expr: &ruleRefExpr{
+								pos:  position{line: 698, col: 127, offset: 26404},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 698, col: 139, offset: 26416},
+							label: "high",
+							expr: &ruleRefExpr{
+								pos:  position{line: 698, col: 144, offset: 26421},
+								name: "AddExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LikeTail",
+			pos:  position{line: 701, col: 1, offset: 26540},
+			expr: &actionExpr{
+				pos: position{line: 701, col: 13, offset: 26552},
+				run: (*parser).callonLikeTail1,
+				expr: &seqExpr{
+					pos: position{line: 701, col: 13, offset: 26552},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 701, col: 13, offset: 26552},
+							label: "not",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 701, col: 17, offset: 26556},
+								expr: &seqExpr{
+									pos: position{line: 701, col: 18, offset: 26557},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 701, col: 18, offset: 26557},
+											val:        "not",
+											ignoreCase: true,
+											want:       "\"NOT\"i",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 701, col: 25, offset: 26564},
+											name: "WhiteSpace",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 701, col: 38, offset: 26577},
+							val:        "like",
+							ignoreCase: true,
+							want:       "\"LIKE\"i",
+						},
+						&notExpr{
+							pos: position{line: 701, col: 46, offset: 26585},
+							expr: &ruleRefExpr{
+								pos:  position{line: 701, col: 47, offset: 26586},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 701, col: 62, offset: 26601},
+							expr: &ruleRefExpr{
+								pos:  position{line: 701, col: 62, offset: 26601},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 701, col: 74, offset: 26613},
+							label: "right",
+							expr: &ruleRefExpr{
+								pos:  position{line: 701, col: 80, offset: 26619},
+								name: "AddExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CompareTail",
+			pos:  position{line: 708, col: 1, offset: 26766},
+			expr: &actionExpr{
+				pos: position{line: 708, col: 16, offset: 26781},
+				run: (*parser).callonCompareTail1,
+				expr: &seqExpr{
+					pos: position{line: 708, col: 16, offset: 26781},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 708, col: 16, offset: 26781},
+							label: "op",
+							expr: &choiceExpr{
+								pos: position{line: 708, col: 20, offset: 26785},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 708, col: 20, offset: 26785},
+										val:        "<=",
+										ignoreCase: false,
+										want:       "\"<=\"",
+									},
+									&litMatcher{
+										pos:        position{line: 708, col: 27, offset: 26792},
+										val:        ">=",
+										ignoreCase: false,
+										want:       "\">=\"",
+									},
+									&litMatcher{
+										pos:        position{line: 708, col: 34, offset: 26799},
+										val:        "<>",
+										ignoreCase: false,
+										want:       "\"<>\"",
+									},
+									&litMatcher{
+										pos:        position{line: 708, col: 41, offset: 26806},
+										val:        "!=",
+										ignoreCase: false,
+										want:       "\"!=\"",
+									},
+									&litMatcher{
+										pos:        position{line: 708, col: 48, offset: 26813},
+										val:        "^=",
+										ignoreCase: false,
+										want:       "\"^=\"",
+									},
+									&litMatcher{
+										pos:        position{line: 708, col: 55, offset: 26820},
+										val:        "=",
+										ignoreCase: false,
+										want:       "\"=\"",
+									},
+									&litMatcher{
+										pos:        position{line: 708, col: 61, offset: 26826},
+										val:        "<",
+										ignoreCase: false,
+										want:       "\"<\"",
+									},
+									&litMatcher{
+										pos:        position{line: 708, col: 67, offset: 26832},
+										val:        ">",
+										ignoreCase: false,
+										want:       "\">\"",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 708, col: 72, offset: 26837},
+							expr: &ruleRefExpr{
+								pos:  position{line: 708, col: 72, offset: 26837},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 708, col: 84, offset: 26849},
+							label: "right",
+							expr: &ruleRefExpr{
+								pos:  position{line: 708, col: 90, offset: 26855},
+								name: "AddExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AddExpr",
+			pos:  position{line: 712, col: 1, offset: 26961},
+			expr: &actionExpr{
+				pos: position{line: 712, col: 12, offset: 26972},
+				run: (*parser).callonAddExpr1,
+				expr: &seqExpr{
+					pos: position{line: 712, col: 12, offset: 26972},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 712, col: 12, offset: 26972},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 712, col: 18, offset: 26978},
+								name: "MulExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 712, col: 26, offset: 26986},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 712, col: 31, offset: 26991},
+								expr: &ruleRefExpr{
+									pos:  position{line: 712, col: 31, offset: 26991},
+									name: "AddRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AddRest",
+			pos:  position{line: 715, col: 1, offset: 27046},
+			expr: &actionExpr{
+				pos: position{line: 715, col: 12, offset: 27057},
+				run: (*parser).callonAddRest1,
+				expr: &seqExpr{
+					pos: position{line: 715, col: 12, offset: 27057},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 715, col: 12, offset: 27057},
+							expr: &ruleRefExpr{
+								pos:  position{line: 715, col: 12, offset: 27057},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 715, col: 24, offset: 27069},
+							label: "op",
+							expr: &choiceExpr{
+								pos: position{line: 715, col: 28, offset: 27073},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 715, col: 28, offset: 27073},
+										val:        "||",
+										ignoreCase: false,
+										want:       "\"||\"",
+									},
+									&litMatcher{
+										pos:        position{line: 715, col: 35, offset: 27080},
+										val:        "+",
+										ignoreCase: false,
+										want:       "\"+\"",
+									},
+									&litMatcher{
+										pos:        position{line: 715, col: 41, offset: 27086},
+										val:        "-",
+										ignoreCase: false,
+										want:       "\"-\"",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 715, col: 46, offset: 27091},
+							expr: &ruleRefExpr{
+								pos:  position{line: 715, col: 46, offset: 27091},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 715, col: 58, offset: 27103},
+							label: "right",
+							expr: &ruleRefExpr{
+								pos:  position{line: 715, col: 64, offset: 27109},
+								name: "MulExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MulExpr",
+			pos:  position{line: 718, col: 1, offset: 27213},
+			expr: &actionExpr{
+				pos: position{line: 718, col: 12, offset: 27224},
+				run: (*parser).callonMulExpr1,
+				expr: &seqExpr{
+					pos: position{line: 718, col: 12, offset: 27224},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 718, col: 12, offset: 27224},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 718, col: 18, offset: 27230},
+								name: "UnaryExpr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 718, col: 28, offset: 27240},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 718, col: 33, offset: 27245},
+								expr: &ruleRefExpr{
+									pos:  position{line: 718, col: 33, offset: 27245},
+									name: "MulRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MulRest",
+			pos:  position{line: 721, col: 1, offset: 27300},
+			expr: &actionExpr{
+				pos: position{line: 721, col: 12, offset: 27311},
+				run: (*parser).callonMulRest1,
+				expr: &seqExpr{
+					pos: position{line: 721, col: 12, offset: 27311},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 721, col: 12, offset: 27311},
+							expr: &ruleRefExpr{
+								pos:  position{line: 721, col: 12, offset: 27311},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 721, col: 24, offset: 27323},
+							label: "op",
+							expr: &choiceExpr{
+								pos: position{line: 721, col: 28, offset: 27327},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 721, col: 28, offset: 27327},
+										val:        "*",
+										ignoreCase: false,
+										want:       "\"*\"",
+									},
+									&litMatcher{
+										pos:        position{line: 721, col: 34, offset: 27333},
+										val:        "/",
+										ignoreCase: false,
+										want:       "\"/\"",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 721, col: 39, offset: 27338},
+							expr: &ruleRefExpr{
+								pos:  position{line: 721, col: 39, offset: 27338},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 721, col: 51, offset: 27350},
+							label: "right",
+							expr: &ruleRefExpr{
+								pos:  position{line: 721, col: 57, offset: 27356},
+								name: "UnaryExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UnaryExpr",
+			pos:  position{line: 724, col: 1, offset: 27462},
+			expr: &choiceExpr{
+				pos: position{line: 724, col: 14, offset: 27475},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 724, col: 14, offset: 27475},
+						run: (*parser).callonUnaryExpr2,
+						expr: &seqExpr{
+							pos: position{line: 724, col: 14, offset: 27475},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 724, col: 14, offset: 27475},
+									label: "op",
+									expr: &choiceExpr{
+										pos: position{line: 724, col: 18, offset: 27479},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 724, col: 18, offset: 27479},
+												val:        "+",
+												ignoreCase: false,
+												want:       "\"+\"",
+											},
+											&litMatcher{
+												pos:        position{line: 724, col: 24, offset: 27485},
+												val:        "-",
+												ignoreCase: false,
+												want:       "\"-\"",
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 724, col: 29, offset: 27490},
+									expr: &ruleRefExpr{
+										pos:  position{line: 724, col: 29, offset: 27490},
+										name: "WhiteSpace",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 724, col: 41, offset: 27502},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 724, col: 43, offset: 27504},
+										name: "UnaryExpr",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 726, col: 5, offset: 27608},
+						name: "PrimaryExpr",
+					},
+				},
+			},
+		},
+		{
+			name: "PrimaryExpr",
+			pos:  position{line: 728, col: 1, offset: 27623},
+			expr: &choiceExpr{
+				pos: position{line: 728, col: 16, offset: 27638},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 728, col: 16, offset: 27638},
+						name: "ParenExpr",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 728, col: 28, offset: 27650},
+						name: "CaseExpr",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 728, col: 39, offset: 27661},
+						name: "LiteralExpr",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 728, col: 53, offset: 27675},
+						name: "FunctionExpr",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 728, col: 68, offset: 27690},
+						name: "NameExpr",
+					},
+				},
+			},
+		},
+		{
+			name: "ParenExpr",
+			pos:  position{line: 729, col: 1, offset: 27700},
+			expr: &actionExpr{
+				pos: position{line: 729, col: 14, offset: 27713},
+				run: (*parser).callonParenExpr1,
+				expr: &seqExpr{
+					pos: position{line: 729, col: 14, offset: 27713},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 729, col: 14, offset: 27713},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 729, col: 18, offset: 27717},
+							expr: &ruleRefExpr{
+								pos:  position{line: 729, col: 18, offset: 27717},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 729, col: 30, offset: 27729},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 729, col: 32, offset: 27731},
+								name: "Expr",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 729, col: 37, offset: 27736},
+							expr: &ruleRefExpr{
+								pos:  position{line: 729, col: 37, offset: 27736},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 729, col: 49, offset: 27748},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CaseExpr",
+			pos:  position{line: 732, col: 1, offset: 27817},
+			expr: &actionExpr{
+				pos: position{line: 732, col: 13, offset: 27829},
+				run: (*parser).callonCaseExpr1,
+				expr: &seqExpr{
+					pos: position{line: 732, col: 13, offset: 27829},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 732, col: 13, offset: 27829},
+							val:        "case",
+							ignoreCase: true,
+							want:       "\"CASE\"i",
+						},
+						&notExpr{
+							pos: position{line: 732, col: 21, offset: 27837},
+							expr: &ruleRefExpr{
+								pos:  position{line: 732, col: 22, offset: 27838},
+								name: "IdentifierChar",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 732, col: 37, offset: 27853},
+							label: "operand",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 732, col: 45, offset: 27861},
+								expr: &ruleRefExpr{
+									pos:  position{line: 732, col: 45, offset: 27861},
+									name: "CaseOperand",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 732, col: 58, offset: 27874},
+							label: "whens",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 732, col: 64, offset: 27880},
+								expr: &ruleRefExpr{
+									pos:  position{line: 732, col: 64, offset: 27880},
+									name: "CaseWhen",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 732, col: 74, offset: 27890},
+							label: "els",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 732, col: 78, offset: 27894},
+								expr: &ruleRefExpr{
+									pos:  position{line: 732, col: 78, offset: 27894},
+									name: "CaseElse",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 732, col: 88, offset: 27904},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 732, col: 99, offset: 27915},
+							val:        "end",
+							ignoreCase: true,
+							want:       "\"END\"i",
+						},
+						&notExpr{
+							pos: position{line: 732, col: 106, offset: 27922},
+							expr: &ruleRefExpr{
+								pos:  position{line: 732, col: 107, offset: 27923},
+								name: "IdentifierChar",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CaseOperand",
+			pos:  position{line: 745, col: 1, offset: 28246},
+			expr: &actionExpr{
+				pos: position{line: 745, col: 16, offset: 28261},
+				run: (*parser).callonCaseOperand1,
+				expr: &seqExpr{
+					pos: position{line: 745, col: 16, offset: 28261},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 745, col: 16, offset: 28261},
+							name: "WhiteSpace",
+						},
+						&notExpr{
+							pos: position{line: 745, col: 27, offset: 28272},
+							expr: &seqExpr{
+								pos: position{line: 745, col: 29, offset: 28274},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 745, col: 29, offset: 28274},
+										val:        "when",
+										ignoreCase: true,
+										want:       "\"WHEN\"i",
+									},
+									&notExpr{
+										pos: position{line: 745, col: 37, offset: 28282},
+										expr: &ruleRefExpr{
+											pos:  position{line: 745, col: 38, offset: 28283},
+											name: "IdentifierChar",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 745, col: 54, offset: 28299},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 745, col: 56, offset: 28301},
+								name: "Expr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CaseWhen",
+			pos:  position{line: 748, col: 1, offset: 28329},
+			expr: &actionExpr{
+				pos: position{line: 748, col: 13, offset: 28341},
+				run: (*parser).callonCaseWhen1,
+				expr: &seqExpr{
+					pos: position{line: 748, col: 13, offset: 28341},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 748, col: 13, offset: 28341},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 748, col: 24, offset: 28352},
+							val:        "when",
+							ignoreCase: true,
+							want:       "\"WHEN\"i",
+						},
+						&notExpr{
+							pos: position{line: 748, col: 32, offset: 28360},
+							expr: &ruleRefExpr{
+								pos:  position{line: 748, col: 33, offset: 28361},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 748, col: 48, offset: 28376},
+							expr: &ruleRefExpr{
+								pos:  position{line: 748, col: 48, offset: 28376},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 748, col: 60, offset: 28388},
+							label: "when",
+							expr: &ruleRefExpr{
+								pos:  position{line: 748, col: 65, offset: 28393},
+								name: "Expr",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 748, col: 70, offset: 28398},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 748, col: 81, offset: 28409},
+							val:        "then",
+							ignoreCase: true,
+							want:       "\"THEN\"i",
+						},
+						&notExpr{
+							pos: position{line: 748, col: 89, offset: 28417},
+							expr: &ruleRefExpr{
+								pos:  position{line: 748, col: 90, offset: 28418},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 748, col: 105, offset: 28433},
+							expr: &ruleRefExpr{
+								pos:  position{line: 748, col: 105, offset: 28433},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 748, col: 117, offset: 28445},
+							label: "then",
+							expr: &ruleRefExpr{
+								pos:  position{line: 748, col: 122, offset: 28450},
+								name: "Expr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CaseElse",
+			pos:  position{line: 751, col: 1, offset: 28548},
+			expr: &actionExpr{
+				pos: position{line: 751, col: 13, offset: 28560},
+				run: (*parser).callonCaseElse1,
+				expr: &seqExpr{
+					pos: position{line: 751, col: 13, offset: 28560},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 751, col: 13, offset: 28560},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 751, col: 24, offset: 28571},
+							val:        "else",
+							ignoreCase: true,
+							want:       "\"ELSE\"i",
+						},
+						&notExpr{
+							pos: position{line: 751, col: 32, offset: 28579},
+							expr: &ruleRefExpr{
+								pos:  position{line: 751, col: 33, offset: 28580},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 751, col: 48, offset: 28595},
+							expr: &ruleRefExpr{
+								pos:  position{line: 751, col: 48, offset: 28595},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 751, col: 60, offset: 28607},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 751, col: 62, offset: 28609},
+								name: "Expr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralExpr",
+			pos:  position{line: 754, col: 1, offset: 28637},
+			expr: &choiceExpr{
+				pos: position{line: 754, col: 16, offset: 28652},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 754, col: 16, offset: 28652},
+						run: (*parser).callonLiteralExpr2,
+						expr: &labeledExpr{
+							pos:   position{line: 754, col: 16, offset: 28652},
+							label: "s",
+							expr: &ruleRefExpr{
+								pos:  position{line: 754, col: 18, offset: 28654},
+								name: "LiteralStringSingleQuote",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 756, col: 5, offset: 28771},
+						run: (*parser).callonLiteralExpr5,
+						expr: &seqExpr{
+							pos: position{line: 756, col: 5, offset: 28771},
+							exprs: []any{
+								&choiceExpr{
+									pos: position{line: 756, col: 6, offset: 28772},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 756, col: 6, offset: 28772},
+											name: "Float",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 756, col: 14, offset: 28780},
+											name: "Integer",
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 756, col: 23, offset: 28789},
+									expr: &ruleRefExpr{
+										pos:  position{line: 756, col: 24, offset: 28790},
+										name: "IdentifierChar",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 758, col: 5, offset: 28901},
+						run: (*parser).callonLiteralExpr12,
+						expr: &seqExpr{
+							pos: position{line: 758, col: 5, offset: 28901},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 758, col: 5, offset: 28901},
+									val:        "null",
+									ignoreCase: true,
+									want:       "\"NULL\"i",
+								},
+								&notExpr{
+									pos: position{line: 758, col: 13, offset: 28909},
+									expr: &ruleRefExpr{
+										pos:  position{line: 758, col: 14, offset: 28910},
+										name: "IdentifierChar",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FunctionExpr",
+			pos:  position{line: 761, col: 1, offset: 28995},
+			expr: &choiceExpr{
+				pos: position{line: 761, col: 17, offset: 29011},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 761, col: 17, offset: 29011},
+						run: (*parser).callonFunctionExpr2,
+						expr: &seqExpr{
+							pos: position{line: 761, col: 17, offset: 29011},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 761, col: 17, offset: 29011},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 761, col: 22, offset: 29016},
+										name: "ExprName",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 761, col: 31, offset: 29025},
+									expr: &ruleRefExpr{
+										pos:  position{line: 761, col: 31, offset: 29025},
+										name: "WhiteSpace",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 761, col: 43, offset: 29037},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 761, col: 47, offset: 29041},
+									expr: &ruleRefExpr{
+										pos:  position{line: 761, col: 47, offset: 29041},
+										name: "WhiteSpace",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 761, col: 59, offset: 29053},
+									val:        "*",
+									ignoreCase: false,
+									want:       "\"*\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 761, col: 63, offset: 29057},
+									expr: &ruleRefExpr{
+										pos:  position{line: 761, col: 63, offset: 29057},
+										name: "WhiteSpace",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 761, col: 75, offset: 29069},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 763, col: 5, offset: 29191},
+						run: (*parser).callonFunctionExpr15,
+						expr: &seqExpr{
+							pos: position{line: 763, col: 5, offset: 29191},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 763, col: 5, offset: 29191},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 763, col: 10, offset: 29196},
+										name: "ExprName",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 763, col: 19, offset: 29205},
+									expr: &ruleRefExpr{
+										pos:  position{line: 763, col: 19, offset: 29205},
+										name: "WhiteSpace",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 763, col: 31, offset: 29217},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 763, col: 35, offset: 29221},
+									expr: &ruleRefExpr{
+										pos:  position{line: 763, col: 35, offset: 29221},
+										name: "WhiteSpace",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 763, col: 47, offset: 29233},
+									label: "args",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 763, col: 52, offset: 29238},
+										expr: &ruleRefExpr{
+											pos:  position{line: 763, col: 52, offset: 29238},
+											name: "ExprList",
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 763, col: 62, offset: 29248},
+									expr: &ruleRefExpr{
+										pos:  position{line: 763, col: 62, offset: 29248},
+										name: "WhiteSpace",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 763, col: 74, offset: 29260},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExprList",
+			pos:  position{line: 770, col: 1, offset: 29455},
+			expr: &actionExpr{
+				pos: position{line: 770, col: 13, offset: 29467},
+				run: (*parser).callonExprList1,
+				expr: &seqExpr{
+					pos: position{line: 770, col: 13, offset: 29467},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 770, col: 13, offset: 29467},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 770, col: 19, offset: 29473},
+								name: "Expr",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 770, col: 24, offset: 29478},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 770, col: 29, offset: 29483},
+								expr: &seqExpr{
+									pos: position{line: 770, col: 30, offset: 29484},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 770, col: 30, offset: 29484},
+											expr: &ruleRefExpr{
+												pos:  position{line: 770, col: 30, offset: 29484},
+												name: "WhiteSpace",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 770, col: 42, offset: 29496},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 770, col: 46, offset: 29500},
+											expr: &ruleRefExpr{
+												pos:  position{line: 770, col: 46, offset: 29500},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 770, col: 58, offset: 29512},
+											name: "Expr",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NameExpr",
+			pos:  position{line: 777, col: 1, offset: 29700},
+			expr: &actionExpr{
+				pos: position{line: 777, col: 13, offset: 29712},
+				run: (*parser).callonNameExpr1,
+				expr: &labeledExpr{
+					pos:   position{line: 777, col: 13, offset: 29712},
+					label: "parts",
+					expr: &ruleRefExpr{
+						pos:  position{line: 777, col: 19, offset: 29718},
+						name: "ExprName",
+					},
+				},
+			},
+		},
+		{
+			name: "ExprName",
+			pos:  position{line: 780, col: 1, offset: 29801},
+			expr: &actionExpr{
+				pos: position{line: 780, col: 13, offset: 29813},
+				run: (*parser).callonExprName1,
+				expr: &seqExpr{
+					pos: position{line: 780, col: 13, offset: 29813},
+					exprs: []any{
+						&notExpr{
+							pos: position{line: 780, col: 13, offset: 29813},
+							expr: &ruleRefExpr{
+								pos:  position{line: 780, col: 14, offset: 29814},
+								name: "ExprKeyword",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 780, col: 26, offset: 29826},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 780, col: 32, offset: 29832},
+								name: "ExprNamePart",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 780, col: 45, offset: 29845},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 780, col: 50, offset: 29850},
+								expr: &seqExpr{
+									pos: position{line: 780, col: 51, offset: 29851},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 780, col: 51, offset: 29851},
+											val:        ".",
+											ignoreCase: false,
+											want:       "\".\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 780, col: 55, offset: 29855},
+											name: "ExprNamePart",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExprNamePart",
+			pos:  position{line: 787, col: 1, offset: 30063},
+			expr: &choiceExpr{
+				pos: position{line: 787, col: 17, offset: 30079},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 787, col: 17, offset: 30079},
+						run: (*parser).callonExprNamePart2,
+						expr: &labeledExpr{
+							pos:   position{line: 787, col: 17, offset: 30079},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 787, col: 22, offset: 30084},
+								name: "LiteralStringDoubleQuote",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 789, col: 5, offset: 30183},
+						run: (*parser).callonExprNamePart5,
+						expr: &seqExpr{
+							pos: position{line: 789, col: 5, offset: 30183},
+							exprs: []any{
+								&charClassMatcher{
+									pos:        position{line: 789, col: 5, offset: 30183},
+									val:        "[a-zA-Z_]",
+									chars:      []rune{'_'},
+									ranges:     []rune{'a', 'z', 'A', 'Z'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 789, col: 15, offset: 30193},
+									expr: &ruleRefExpr{
+										pos:  position{line: 789, col: 15, offset: 30193},
+										name: "IdentifierChar",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExprKeyword",
+			pos:  position{line: 792, col: 1, offset: 30269},
+			expr: &seqExpr{
+				pos: position{line: 792, col: 16, offset: 30284},
+				exprs: []any{
+					&choiceExpr{
+						pos: position{line: 792, col: 17, offset: 30285},
+						alternatives: []any{
+							&litMatcher{
+								pos:        position{line: 792, col: 17, offset: 30285},
+								val:        "and",
+								ignoreCase: true,
+								want:       "\"AND\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 26, offset: 30294},
+								val:        "between",
+								ignoreCase: true,
+								want:       "\"BETWEEN\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 39, offset: 30307},
+								val:        "case",
+								ignoreCase: true,
+								want:       "\"CASE\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 49, offset: 30317},
+								val:        "else",
+								ignoreCase: true,
+								want:       "\"ELSE\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 59, offset: 30327},
+								val:        "end",
+								ignoreCase: true,
+								want:       "\"END\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 68, offset: 30336},
+								val:        "in",
+								ignoreCase: true,
+								want:       "\"IN\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 76, offset: 30344},
+								val:        "is",
+								ignoreCase: true,
+								want:       "\"IS\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 84, offset: 30352},
+								val:        "like",
+								ignoreCase: true,
+								want:       "\"LIKE\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 94, offset: 30362},
+								val:        "not",
+								ignoreCase: true,
+								want:       "\"NOT\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 103, offset: 30371},
+								val:        "null",
+								ignoreCase: true,
+								want:       "\"NULL\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 113, offset: 30381},
+								val:        "or",
+								ignoreCase: true,
+								want:       "\"OR\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 121, offset: 30389},
+								val:        "then",
+								ignoreCase: true,
+								want:       "\"THEN\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 792, col: 131, offset: 30399},
+								val:        "when",
+								ignoreCase: true,
+								want:       "\"WHEN\"i",
+							},
+						},
+					},
+					&notExpr{
+						pos: position{line: 792, col: 140, offset: 30408},
+						expr: &ruleRefExpr{
+							pos:  position{line: 792, col: 141, offset: 30409},
+							name: "IdentifierChar",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnType",
+			pos:  position{line: 794, col: 1, offset: 30427},
+			expr: &choiceExpr{
+				pos: position{line: 794, col: 15, offset: 30441},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 794, col: 15, offset: 30441},
+						name: "IntervalType",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 794, col: 30, offset: 30456},
+						name: "TimestampType",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 794, col: 46, offset: 30472},
+						name: "BuiltinType",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 794, col: 60, offset: 30486},
+						name: "ObjectType",
+					},
+				},
+			},
+		},
+		{
+			name: "IntervalType",
+			pos:  position{line: 797, col: 1, offset: 30580},
+			expr: &choiceExpr{
+				pos: position{line: 797, col: 17, offset: 30596},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 797, col: 17, offset: 30596},
+						run: (*parser).callonIntervalType2,
+						expr: &seqExpr{
+							pos: position{line: 797, col: 17, offset: 30596},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 797, col: 17, offset: 30596},
+									val:        "INTERVAL",
+									ignoreCase: false,
+									want:       "\"INTERVAL\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 797, col: 28, offset: 30607},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 797, col: 39, offset: 30618},
+									val:        "YEAR",
+									ignoreCase: false,
+									want:       "\"YEAR\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 797, col: 46, offset: 30625},
+									label: "p",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 797, col: 48, offset: 30627},
+										expr: &ruleRefExpr{
+											pos:  position{line: 797, col: 48, offset: 30627},
+											name: "TypePrecision",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 797, col: 63, offset: 30642},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 797, col: 74, offset: 30653},
+									val:        "TO",
+									ignoreCase: false,
+									want:       "\"TO\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 797, col: 79, offset: 30658},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 797, col: 90, offset: 30669},
+									val:        "MONTH",
+									ignoreCase: false,
+									want:       "\"MONTH\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 803, col: 5, offset: 30836},
+						run: (*parser).callonIntervalType14,
+						expr: &seqExpr{
+							pos: position{line: 803, col: 5, offset: 30836},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 803, col: 5, offset: 30836},
+									val:        "INTERVAL",
+									ignoreCase: false,
+									want:       "\"INTERVAL\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 803, col: 16, offset: 30847},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 803, col: 27, offset: 30858},
+									val:        "DAY",
+									ignoreCase: false,
+									want:       "\"DAY\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 803, col: 33, offset: 30864},
+									label: "p",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 803, col: 35, offset: 30866},
+										expr: &ruleRefExpr{
+											pos:  position{line: 803, col: 35, offset: 30866},
+											name: "TypePrecision",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 803, col: 50, offset: 30881},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 803, col: 61, offset: 30892},
+									val:        "TO",
+									ignoreCase: false,
+									want:       "\"TO\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 803, col: 66, offset: 30897},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 803, col: 77, offset: 30908},
+									val:        "SECOND",
+									ignoreCase: false,
+									want:       "\"SECOND\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 803, col: 86, offset: 30917},
+									label: "f",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 803, col: 88, offset: 30919},
+										expr: &ruleRefExpr{
+											pos:  position{line: 803, col: 88, offset: 30919},
+											name: "TypePrecision",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TimestampType",
+			pos:  position{line: 814, col: 1, offset: 31178},
+			expr: &actionExpr{
+				pos: position{line: 814, col: 18, offset: 31195},
+				run: (*parser).callonTimestampType1,
+				expr: &seqExpr{
+					pos: position{line: 814, col: 18, offset: 31195},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 814, col: 18, offset: 31195},
+							val:        "TIMESTAMP",
+							ignoreCase: false,
+							want:       "\"TIMESTAMP\"",
+						},
+						&notExpr{
+							pos: position{line: 814, col: 30, offset: 31207},
+							expr: &ruleRefExpr{
+								pos:  position{line: 814, col: 31, offset: 31208},
+								name: "IdentifierChar",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 814, col: 46, offset: 31223},
+							label: "f",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 814, col: 48, offset: 31225},
+								expr: &ruleRefExpr{
+									pos:  position{line: 814, col: 48, offset: 31225},
+									name: "TypePrecision",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 814, col: 63, offset: 31240},
+							label: "tz",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 814, col: 66, offset: 31243},
+								expr: &seqExpr{
+									pos: position{line: 814, col: 67, offset: 31244},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 814, col: 67, offset: 31244},
+											name: "WhiteSpace",
+										},
+										&litMatcher{
+											pos:        position{line: 814, col: 78, offset: 31255},
+											val:        "WITH",
+											ignoreCase: false,
+											want:       "\"WITH\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 814, col: 85, offset: 31262},
+											name: "WhiteSpace",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 814, col: 96, offset: 31273},
+											name: "TimeZoneKind",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TimeZoneKind",
+			pos:  position{line: 824, col: 1, offset: 31518},
+			expr: &actionExpr{
+				pos: position{line: 824, col: 17, offset: 31534},
+				run: (*parser).callonTimeZoneKind1,
+				expr: &seqExpr{
+					pos: position{line: 824, col: 17, offset: 31534},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 824, col: 17, offset: 31534},
+							expr: &seqExpr{
+								pos: position{line: 824, col: 18, offset: 31535},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 824, col: 18, offset: 31535},
+										val:        "LOCAL",
+										ignoreCase: false,
+										want:       "\"LOCAL\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 824, col: 26, offset: 31543},
+										name: "WhiteSpace",
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 824, col: 39, offset: 31556},
+							val:        "TIME",
+							ignoreCase: false,
+							want:       "\"TIME\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 824, col: 46, offset: 31563},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 824, col: 57, offset: 31574},
+							val:        "ZONE",
+							ignoreCase: false,
+							want:       "\"ZONE\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TypePrecision",
+			pos:  position{line: 828, col: 1, offset: 31654},
+			expr: &actionExpr{
+				pos: position{line: 828, col: 18, offset: 31671},
+				run: (*parser).callonTypePrecision1,
+				expr: &seqExpr{
+					pos: position{line: 828, col: 18, offset: 31671},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 828, col: 18, offset: 31671},
+							expr: &ruleRefExpr{
+								pos:  position{line: 828, col: 18, offset: 31671},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 828, col: 30, offset: 31683},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 828, col: 34, offset: 31687},
+							expr: &ruleRefExpr{
+								pos:  position{line: 828, col: 34, offset: 31687},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 828, col: 46, offset: 31699},
+							label: "n",
+							expr: &ruleRefExpr{
+								pos:  position{line: 828, col: 48, offset: 31701},
+								name: "Digits",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 828, col: 55, offset: 31708},
+							expr: &ruleRefExpr{
+								pos:  position{line: 828, col: 55, offset: 31708},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 828, col: 67, offset: 31720},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BuiltinType",
+			pos:  position{line: 833, col: 1, offset: 31801},
+			expr: &actionExpr{
+				pos: position{line: 833, col: 16, offset: 31816},
+				run: (*parser).callonBuiltinType1,
+				expr: &seqExpr{
+					pos: position{line: 833, col: 16, offset: 31816},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 833, col: 16, offset: 31816},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 833, col: 21, offset: 31821},
+								name: "BuiltinTypeName",
+							},
+						},
+						&notExpr{
+							pos: position{line: 833, col: 37, offset: 31837},
+							expr: &ruleRefExpr{
+								pos:  position{line: 833, col: 38, offset: 31838},
+								name: "IdentifierChar",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 833, col: 53, offset: 31853},
+							label: "args",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 833, col: 58, offset: 31858},
+								expr: &seqExpr{
+									pos: position{line: 833, col: 59, offset: 31859},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 833, col: 59, offset: 31859},
+											expr: &ruleRefExpr{
+												pos:  position{line: 833, col: 59, offset: 31859},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 833, col: 71, offset: 31871},
+											name: "ColumnTypeArgs",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BuiltinTypeName",
+			pos:  position{line: 840, col: 1, offset: 32037},
+			expr: &actionExpr{
+				pos: position{line: 840, col: 20, offset: 32056},
+				run: (*parser).callonBuiltinTypeName1,
+				expr: &choiceExpr{
+					pos: position{line: 840, col: 21, offset: 32057},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 840, col: 21, offset: 32057},
+							val:        "BINARY_DOUBLE",
+							ignoreCase: false,
+							want:       "\"BINARY_DOUBLE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 39, offset: 32075},
+							val:        "BINARY_FLOAT",
+							ignoreCase: false,
+							want:       "\"BINARY_FLOAT\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 56, offset: 32092},
+							val:        "BFILE",
+							ignoreCase: false,
+							want:       "\"BFILE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 66, offset: 32102},
+							val:        "BLOB",
+							ignoreCase: false,
+							want:       "\"BLOB\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 75, offset: 32111},
+							val:        "BOOLEAN",
+							ignoreCase: false,
+							want:       "\"BOOLEAN\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 87, offset: 32123},
+							val:        "CHAR",
+							ignoreCase: false,
+							want:       "\"CHAR\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 96, offset: 32132},
+							val:        "CLOB",
+							ignoreCase: false,
+							want:       "\"CLOB\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 105, offset: 32141},
+							val:        "DATE",
+							ignoreCase: false,
+							want:       "\"DATE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 114, offset: 32150},
+							val:        "DECIMAL",
+							ignoreCase: false,
+							want:       "\"DECIMAL\"",
+						},
+						&seqExpr{
+							pos: position{line: 840, col: 126, offset: 32162},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 840, col: 126, offset: 32162},
+									val:        "DOUBLE",
+									ignoreCase: false,
+									want:       "\"DOUBLE\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 840, col: 135, offset: 32171},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 840, col: 146, offset: 32182},
+									val:        "PRECISION",
+									ignoreCase: false,
+									want:       "\"PRECISION\"",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 160, offset: 32196},
+							val:        "FLOAT",
+							ignoreCase: false,
+							want:       "\"FLOAT\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 170, offset: 32206},
+							val:        "INTEGER",
+							ignoreCase: false,
+							want:       "\"INTEGER\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 182, offset: 32218},
+							val:        "INT",
+							ignoreCase: false,
+							want:       "\"INT\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 190, offset: 32226},
+							val:        "JSON",
+							ignoreCase: false,
+							want:       "\"JSON\"",
+						},
+						&seqExpr{
+							pos: position{line: 840, col: 199, offset: 32235},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 840, col: 199, offset: 32235},
+									val:        "LONG",
+									ignoreCase: false,
+									want:       "\"LONG\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 840, col: 206, offset: 32242},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 840, col: 217, offset: 32253},
+									val:        "RAW",
+									ignoreCase: false,
+									want:       "\"RAW\"",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 225, offset: 32261},
+							val:        "LONG",
+							ignoreCase: false,
+							want:       "\"LONG\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 234, offset: 32270},
+							val:        "NCHAR",
+							ignoreCase: false,
+							want:       "\"NCHAR\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 244, offset: 32280},
+							val:        "NCLOB",
+							ignoreCase: false,
+							want:       "\"NCLOB\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 254, offset: 32290},
+							val:        "NUMBER",
+							ignoreCase: false,
+							want:       "\"NUMBER\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 265, offset: 32301},
+							val:        "NUMERICAL",
+							ignoreCase: false,
+							want:       "\"NUMERICAL\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 279, offset: 32315},
+							val:        "NUMERIC",
+							ignoreCase: false,
+							want:       "\"NUMERIC\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 291, offset: 32327},
+							val:        "NVARCHAR2",
+							ignoreCase: false,
+							want:       "\"NVARCHAR2\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 305, offset: 32341},
+							val:        "RAW",
+							ignoreCase: false,
+							want:       "\"RAW\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 313, offset: 32349},
+							val:        "REAL",
+							ignoreCase: false,
+							want:       "\"REAL\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 322, offset: 32358},
+							val:        "ROWID",
+							ignoreCase: false,
+							want:       "\"ROWID\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 332, offset: 32368},
+							val:        "SMALLINT",
+							ignoreCase: false,
+							want:       "\"SMALLINT\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 345, offset: 32381},
+							val:        "UROWID",
+							ignoreCase: false,
+							want:       "\"UROWID\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 356, offset: 32392},
+							val:        "VARCHAR2",
+							ignoreCase: false,
+							want:       "\"VARCHAR2\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 369, offset: 32405},
+							val:        "VARCHAR",
+							ignoreCase: false,
+							want:       "\"VARCHAR\"",
+						},
+						&litMatcher{
+							pos:        position{line: 840, col: 381, offset: 32417},
+							val:        "XMLTYPE",
+							ignoreCase: false,
+							want:       "\"XMLTYPE\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ObjectType",
+			pos:  position{line: 845, col: 1, offset: 32599},
+			expr: &actionExpr{
+				pos: position{line: 845, col: 15, offset: 32613},
+				run: (*parser).callonObjectType1,
+				expr: &seqExpr{
+					pos: position{line: 845, col: 15, offset: 32613},
+					exprs: []any{
+						&notExpr{
+							pos: position{line: 845, col: 15, offset: 32613},
+							expr: &ruleRefExpr{
+								pos:  position{line: 845, col: 16, offset: 32614},
+								name: "ColumnKeyword",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 845, col: 30, offset: 32628},
+							label: "parts",
+							expr: &ruleRefExpr{
+								pos:  position{line: 845, col: 36, offset: 32634},
+								name: "NameParts",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnKeyword",
+			pos:  position{line: 851, col: 1, offset: 32889},
+			expr: &seqExpr{
+				pos: position{line: 851, col: 18, offset: 32906},
+				exprs: []any{
+					&choiceExpr{
+						pos: position{line: 851, col: 19, offset: 32907},
+						alternatives: []any{
+							&litMatcher{
+								pos:        position{line: 851, col: 19, offset: 32907},
+								val:        "AS",
+								ignoreCase: false,
+								want:       "\"AS\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 26, offset: 32914},
+								val:        "CONSTRAINT",
+								ignoreCase: false,
+								want:       "\"CONSTRAINT\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 41, offset: 32929},
+								val:        "CHECK",
+								ignoreCase: false,
+								want:       "\"CHECK\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 51, offset: 32939},
+								val:        "DEFAULT",
+								ignoreCase: false,
+								want:       "\"DEFAULT\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 63, offset: 32951},
+								val:        "DISABLE",
+								ignoreCase: false,
+								want:       "\"DISABLE\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 75, offset: 32963},
+								val:        "ENABLE",
+								ignoreCase: false,
+								want:       "\"ENABLE\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 86, offset: 32974},
+								val:        "GENERATED",
+								ignoreCase: false,
+								want:       "\"GENERATED\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 100, offset: 32988},
+								val:        "NOT",
+								ignoreCase: false,
+								want:       "\"NOT\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 108, offset: 32996},
+								val:        "NULL",
+								ignoreCase: false,
+								want:       "\"NULL\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 117, offset: 33005},
+								val:        "PRIMARY",
+								ignoreCase: false,
+								want:       "\"PRIMARY\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 129, offset: 33017},
+								val:        "REFERENCES",
+								ignoreCase: false,
+								want:       "\"REFERENCES\"",
+							},
+							&litMatcher{
+								pos:        position{line: 851, col: 144, offset: 33032},
+								val:        "UNIQUE",
+								ignoreCase: false,
+								want:       "\"UNIQUE\"",
+							},
+						},
+					},
+					&notExpr{
+						pos: position{line: 851, col: 154, offset: 33042},
+						expr: &ruleRefExpr{
+							pos:  position{line: 851, col: 155, offset: 33043},
+							name: "IdentifierChar",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IdentifierChar",
+			pos:  position{line: 853, col: 1, offset: 33061},
+			expr: &charClassMatcher{
+				pos:        position{line: 853, col: 19, offset: 33079},
+				val:        "[a-zA-Z0-9_$#]",
+				chars:      []rune{'_', '$', '#'},
+				ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "ColumnTypeArgs",
+			pos:  position{line: 855, col: 1, offset: 33097},
+			expr: &actionExpr{
+				pos: position{line: 855, col: 19, offset: 33115},
+				run: (*parser).callonColumnTypeArgs1,
+				expr: &seqExpr{
+					pos: position{line: 855, col: 19, offset: 33115},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 855, col: 19, offset: 33115},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 855, col: 23, offset: 33119},
+							label: "args",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 855, col: 28, offset: 33124},
+								expr: &ruleRefExpr{
+									pos:  position{line: 855, col: 28, offset: 33124},
+									name: "ColumnTypeArg",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 855, col: 43, offset: 33139},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnTypeArg",
+			pos:  position{line: 863, col: 1, offset: 33317},
+			expr: &actionExpr{
+				pos: position{line: 863, col: 18, offset: 33334},
+				run: (*parser).callonColumnTypeArg1,
+				expr: &seqExpr{
+					pos: position{line: 863, col: 18, offset: 33334},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 863, col: 18, offset: 33334},
+							expr: &ruleRefExpr{
+								pos:  position{line: 863, col: 18, offset: 33334},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 863, col: 30, offset: 33346},
+							label: "num",
+							expr: &choiceExpr{
+								pos: position{line: 863, col: 35, offset: 33351},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 863, col: 35, offset: 33351},
+										name: "NegativeDigits",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 863, col: 50, offset: 33366},
+										name: "Digits",
+									},
+									&litMatcher{
+										pos:        position{line: 863, col: 57, offset: 33373},
+										val:        "*",
+										ignoreCase: false,
+										want:       "\"*\"",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 863, col: 62, offset: 33378},
+							expr: &ruleRefExpr{
+								pos:  position{line: 863, col: 62, offset: 33378},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 863, col: 74, offset: 33390},
+							label: "numType",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 863, col: 82, offset: 33398},
+								expr: &ruleRefExpr{
+									pos:  position{line: 863, col: 82, offset: 33398},
+									name: "ColumnTypeKeyword",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 863, col: 101, offset: 33417},
+							expr: &ruleRefExpr{
+								pos:  position{line: 863, col: 101, offset: 33417},
+								name: "WhiteSpace",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 863, col: 113, offset: 33429},
+							expr: &litMatcher{
+								pos:        position{line: 863, col: 113, offset: 33429},
+								val:        ",",
+								ignoreCase: false,
+								want:       "\",\"",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 863, col: 118, offset: 33434},
+							expr: &ruleRefExpr{
+								pos:  position{line: 863, col: 118, offset: 33434},
+								name: "WhiteSpace",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NegativeDigits",
+			pos:  position{line: 879, col: 1, offset: 33750},
+			expr: &actionExpr{
+				pos: position{line: 879, col: 19, offset: 33768},
+				run: (*parser).callonNegativeDigits1,
+				expr: &seqExpr{
+					pos: position{line: 879, col: 19, offset: 33768},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 879, col: 19, offset: 33768},
+							val:        "-",
+							ignoreCase: false,
+							want:       "\"-\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 879, col: 23, offset: 33772},
+							expr: &ruleRefExpr{
+								pos:  position{line: 879, col: 23, offset: 33772},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 879, col: 35, offset: 33784},
+							label: "n",
+							expr: &ruleRefExpr{
+								pos:  position{line: 879, col: 37, offset: 33786},
+								name: "Digits",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnTypeKeyword",
+			pos:  position{line: 883, col: 1, offset: 33825},
+			expr: &actionExpr{
+				pos: position{line: 883, col: 22, offset: 33846},
+				run: (*parser).callonColumnTypeKeyword1,
+				expr: &choiceExpr{
+					pos: position{line: 883, col: 23, offset: 33847},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 883, col: 23, offset: 33847},
+							val:        "BYTE",
+							ignoreCase: false,
+							want:       "\"BYTE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 883, col: 32, offset: 33856},
+							val:        "CHAR",
+							ignoreCase: false,
+							want:       "\"CHAR\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IgnoreTableEndParams",
+			pos:  position{line: 887, col: 1, offset: 33902},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 887, col: 25, offset: 33926},
+				expr: &seqExpr{
+					pos: position{line: 887, col: 26, offset: 33927},
+					exprs: []any{
+						&notExpr{
+							pos: position{line: 887, col: 26, offset: 33927},
+							expr: &litMatcher{
+								pos:        position{line: 887, col: 27, offset: 33928},
+								val:        ";",
+								ignoreCase: false,
+								want:       "\";\"",
+							},
+						},
+						&anyMatcher{
+							line: 887, col: 31, offset: 33932,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TablePhysical",
+			pos:  position{line: 890, col: 1, offset: 34035},
+			expr: &actionExpr{
+				pos: position{line: 890, col: 18, offset: 34052},
+				run: (*parser).callonTablePhysical1,
+				expr: &seqExpr{
+					pos: position{line: 890, col: 18, offset: 34052},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 890, col: 18, offset: 34052},
+							label: "items",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 890, col: 24, offset: 34058},
+								expr: &seqExpr{
+									pos: position{line: 890, col: 25, offset: 34059},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 890, col: 25, offset: 34059},
+											expr: &ruleRefExpr{
+												pos:  position{line: 890, col: 25, offset: 34059},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 890, col: 37, offset: 34071},
+											name: "TablePhysicalItem",
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 890, col: 57, offset: 34091},
+							expr: &ruleRefExpr{
+								pos:  position{line: 890, col: 57, offset: 34091},
+								name: "WhiteSpace",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TablePhysicalItem",
+			pos:  position{line: 893, col: 1, offset: 34148},
+			expr: &choiceExpr{
+				pos: position{line: 893, col: 22, offset: 34169},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 893, col: 22, offset: 34169},
+						name: "TablePhysicalKnown",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 893, col: 43, offset: 34190},
+						name: "UnparsedToken",
+					},
+				},
+			},
+		},
+		{
+			name: "TemporaryKind",
+			pos:  position{line: 894, col: 1, offset: 34205},
+			expr: &actionExpr{
+				pos: position{line: 894, col: 18, offset: 34222},
+				run: (*parser).callonTemporaryKind1,
+				expr: &seqExpr{
+					pos: position{line: 894, col: 18, offset: 34222},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 894, col: 18, offset: 34222},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 894, col: 24, offset: 34228},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 894, col: 24, offset: 34228},
+										val:        "GLOBAL",
+										ignoreCase: false,
+										want:       "\"GLOBAL\"",
+									},
+									&litMatcher{
+										pos:        position{line: 894, col: 35, offset: 34239},
+										val:        "PRIVATE",
+										ignoreCase: false,
+										want:       "\"PRIVATE\"",
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 894, col: 46, offset: 34250},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 894, col: 57, offset: 34261},
+							val:        "TEMPORARY",
+							ignoreCase: false,
+							want:       "\"TEMPORARY\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TablePhysicalKnown",
+			pos:  position{line: 898, col: 1, offset: 34319},
+			expr: &choiceExpr{
+				pos: position{line: 898, col: 23, offset: 34341},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 898, col: 23, offset: 34341},
+						name: "OnCommitOption",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 898, col: 40, offset: 34358},
+						name: "Partitioning",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 898, col: 55, offset: 34373},
+						name: "OrganizationOption",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 898, col: 76, offset: 34394},
+						name: "IndexOrganizedOption",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 898, col: 99, offset: 34417},
+						name: "TableCompression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 898, col: 118, offset: 34436},
+						name: "LobStorage",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 898, col: 131, offset: 34449},
+						name: "TableFlagOption",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 898, col: 149, offset: 34467},
+						name: "PhysicalOption",
+					},
+				},
+			},
+		},
+		{
+			name: "Partitioning",
+			pos:  position{line: 900, col: 1, offset: 34485},
+			expr: &actionExpr{
+				pos: position{line: 900, col: 17, offset: 34501},
+				run: (*parser).callonPartitioning1,
+				expr: &seqExpr{
+					pos: position{line: 900, col: 17, offset: 34501},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 900, col: 17, offset: 34501},
+							val:        "PARTITION",
+							ignoreCase: false,
+							want:       "\"PARTITION\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 900, col: 29, offset: 34513},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 900, col: 40, offset: 34524},
+							val:        "BY",
+							ignoreCase: false,
+							want:       "\"BY\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 900, col: 45, offset: 34529},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 900, col: 56, offset: 34540},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 900, col: 62, offset: 34546},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 900, col: 62, offset: 34546},
+										val:        "RANGE",
+										ignoreCase: false,
+										want:       "\"RANGE\"",
+									},
+									&litMatcher{
+										pos:        position{line: 900, col: 72, offset: 34556},
+										val:        "LIST",
+										ignoreCase: false,
+										want:       "\"LIST\"",
+									},
+									&litMatcher{
+										pos:        position{line: 900, col: 81, offset: 34565},
+										val:        "HASH",
+										ignoreCase: false,
+										want:       "\"HASH\"",
+									},
+									&litMatcher{
+										pos:        position{line: 900, col: 90, offset: 34574},
+										val:        "REFERENCE",
+										ignoreCase: false,
+										want:       "\"REFERENCE\"",
+									},
+									&litMatcher{
+										pos:        position{line: 900, col: 104, offset: 34588},
+										val:        "SYSTEM",
+										ignoreCase: false,
+										want:       "\"SYSTEM\"",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 900, col: 114, offset: 34598},
+							label: "cols",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 900, col: 119, offset: 34603},
+								expr: &seqExpr{
+									pos: position{line: 900, col: 120, offset: 34604},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 900, col: 120, offset: 34604},
+											expr: &ruleRefExpr{
+												pos:  position{line: 900, col: 120, offset: 34604},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 132, offset: 34616},
+											name: "ColumnList",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 900, col: 145, offset: 34629},
+							label: "interval",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 900, col: 154, offset: 34638},
+								expr: &seqExpr{
+									pos: position{line: 900, col: 155, offset: 34639},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 155, offset: 34639},
+											name: "WhiteSpace",
+										},
+										&litMatcher{
+											pos:        position{line: 900, col: 166, offset: 34650},
+											val:        "INTERVAL",
+											ignoreCase: false,
+											want:       "\"INTERVAL\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 900, col: 177, offset: 34661},
+											expr: &ruleRefExpr{
+												pos:  position{line: 900, col: 177, offset: 34661},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 189, offset: 34673},
+											name: "ParenText",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 900, col: 201, offset: 34685},
+							label: "sub",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 900, col: 205, offset: 34689},
+								expr: &seqExpr{
+									pos: position{line: 900, col: 206, offset: 34690},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 206, offset: 34690},
+											name: "WhiteSpace",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 217, offset: 34701},
+											name: "Subpartitioning",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 900, col: 235, offset: 34719},
+							label: "count",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 900, col: 241, offset: 34725},
+								expr: &seqExpr{
+									pos: position{line: 900, col: 242, offset: 34726},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 242, offset: 34726},
+											name: "WhiteSpace",
+										},
+										&litMatcher{
+											pos:        position{line: 900, col: 253, offset: 34737},
+											val:        "PARTITIONS",
+											ignoreCase: false,
+											want:       "\"PARTITIONS\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 266, offset: 34750},
+											name: "WhiteSpace",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 277, offset: 34761},
+											name: "Digits",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 900, col: 286, offset: 34770},
+							label: "store",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 900, col: 292, offset: 34776},
+								expr: &seqExpr{
+									pos: position{line: 900, col: 293, offset: 34777},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 293, offset: 34777},
+											name: "WhiteSpace",
+										},
+										&litMatcher{
+											pos:        position{line: 900, col: 304, offset: 34788},
+											val:        "STORE",
+											ignoreCase: false,
+											want:       "\"STORE\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 312, offset: 34796},
+											name: "WhiteSpace",
+										},
+										&litMatcher{
+											pos:        position{line: 900, col: 323, offset: 34807},
+											val:        "IN",
+											ignoreCase: false,
+											want:       "\"IN\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 900, col: 328, offset: 34812},
+											expr: &ruleRefExpr{
+												pos:  position{line: 900, col: 328, offset: 34812},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 340, offset: 34824},
+											name: "ColumnList",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 900, col: 353, offset: 34837},
+							label: "parts",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 900, col: 359, offset: 34843},
+								expr: &seqExpr{
+									pos: position{line: 900, col: 360, offset: 34844},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 900, col: 360, offset: 34844},
+											expr: &ruleRefExpr{
+												pos:  position{line: 900, col: 360, offset: 34844},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 900, col: 372, offset: 34856},
+											name: "PartitionList",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Subpartitioning",
+			pos:  position{line: 925, col: 1, offset: 35453},
+			expr: &actionExpr{
+				pos: position{line: 925, col: 20, offset: 35472},
+				run: (*parser).callonSubpartitioning1,
+				expr: &seqExpr{
+					pos: position{line: 925, col: 20, offset: 35472},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 925, col: 20, offset: 35472},
+							val:        "SUBPARTITION",
+							ignoreCase: false,
+							want:       "\"SUBPARTITION\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 925, col: 35, offset: 35487},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 925, col: 46, offset: 35498},
+							val:        "BY",
+							ignoreCase: false,
+							want:       "\"BY\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 925, col: 51, offset: 35503},
+							name: "WhiteSpace",
+						},
+						&choiceExpr{
+							pos: position{line: 925, col: 63, offset: 35515},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 925, col: 63, offset: 35515},
+									val:        "RANGE",
+									ignoreCase: false,
+									want:       "\"RANGE\"",
+								},
+								&litMatcher{
+									pos:        position{line: 925, col: 73, offset: 35525},
+									val:        "LIST",
+									ignoreCase: false,
+									want:       "\"LIST\"",
+								},
+								&litMatcher{
+									pos:        position{line: 925, col: 82, offset: 35534},
+									val:        "HASH",
+									ignoreCase: false,
+									want:       "\"HASH\"",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 925, col: 90, offset: 35542},
+							expr: &ruleRefExpr{
+								pos:  position{line: 925, col: 90, offset: 35542},
+								name: "WhiteSpace",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 925, col: 102, offset: 35554},
+							name: "ColumnList",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 925, col: 113, offset: 35565},
+							expr: &seqExpr{
+								pos: position{line: 925, col: 114, offset: 35566},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 925, col: 114, offset: 35566},
+										name: "WhiteSpace",
+									},
+									&litMatcher{
+										pos:        position{line: 925, col: 125, offset: 35577},
+										val:        "SUBPARTITIONS",
+										ignoreCase: false,
+										want:       "\"SUBPARTITIONS\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 925, col: 141, offset: 35593},
+										name: "WhiteSpace",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 925, col: 152, offset: 35604},
+										name: "Digits",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 925, col: 161, offset: 35613},
+							expr: &seqExpr{
+								pos: position{line: 925, col: 162, offset: 35614},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 925, col: 162, offset: 35614},
+										name: "WhiteSpace",
+									},
+									&litMatcher{
+										pos:        position{line: 925, col: 173, offset: 35625},
+										val:        "SUBPARTITION",
+										ignoreCase: false,
+										want:       "\"SUBPARTITION\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 925, col: 188, offset: 35640},
+										name: "WhiteSpace",
+									},
+									&litMatcher{
+										pos:        position{line: 925, col: 199, offset: 35651},
+										val:        "TEMPLATE",
+										ignoreCase: false,
+										want:       "\"TEMPLATE\"",
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 925, col: 210, offset: 35662},
+										expr: &ruleRefExpr{
+											pos:  position{line: 925, col: 210, offset: 35662},
+											name: "WhiteSpace",
+										},
+									},
+									&litMatcher{
+										pos:        position{line: 925, col: 222, offset: 35674},
+										val:        "(",
+										ignoreCase: false,
+										want:       "\"(\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 925, col: 226, offset: 35678},
+										name: "ParenBody",
+									},
+									&litMatcher{
+										pos:        position{line: 925, col: 236, offset: 35688},
+										val:        ")",
+										ignoreCase: false,
+										want:       "\")\"",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PartitionList",
+			pos:  position{line: 929, col: 1, offset: 35767},
+			expr: &actionExpr{
+				pos: position{line: 929, col: 18, offset: 35784},
+				run: (*parser).callonPartitionList1,
+				expr: &seqExpr{
+					pos: position{line: 929, col: 18, offset: 35784},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 929, col: 18, offset: 35784},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 929, col: 22, offset: 35788},
+							expr: &ruleRefExpr{
+								pos:  position{line: 929, col: 22, offset: 35788},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 929, col: 34, offset: 35800},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 929, col: 40, offset: 35806},
+								name: "PartitionSpec",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 929, col: 54, offset: 35820},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 929, col: 59, offset: 35825},
+								expr: &seqExpr{
+									pos: position{line: 929, col: 60, offset: 35826},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 929, col: 60, offset: 35826},
+											expr: &ruleRefExpr{
+												pos:  position{line: 929, col: 60, offset: 35826},
+												name: "WhiteSpace",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 929, col: 72, offset: 35838},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 929, col: 76, offset: 35842},
+											expr: &ruleRefExpr{
+												pos:  position{line: 929, col: 76, offset: 35842},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 929, col: 88, offset: 35854},
+											name: "PartitionSpec",
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 929, col: 104, offset: 35870},
+							expr: &ruleRefExpr{
+								pos:  position{line: 929, col: 104, offset: 35870},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 929, col: 116, offset: 35882},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PartitionSpec",
+			pos:  position{line: 937, col: 1, offset: 36096},
+			expr: &actionExpr{
+				pos: position{line: 937, col: 18, offset: 36113},
+				run: (*parser).callonPartitionSpec1,
+				expr: &seqExpr{
+					pos: position{line: 937, col: 18, offset: 36113},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 937, col: 18, offset: 36113},
+							val:        "PARTITION",
+							ignoreCase: false,
+							want:       "\"PARTITION\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 937, col: 30, offset: 36125},
+							label: "name",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 937, col: 35, offset: 36130},
+								expr: &seqExpr{
+									pos: position{line: 937, col: 36, offset: 36131},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 937, col: 36, offset: 36131},
+											name: "WhiteSpace",
+										},
+										&notExpr{
+											pos: position{line: 937, col: 47, offset: 36142},
+											expr: &ruleRefExpr{
+												pos:  position{line: 937, col: 48, offset: 36143},
+												name: "PartitionValues",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 937, col: 64, offset: 36159},
+											name: "TableNamePart",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 937, col: 80, offset: 36175},
+							label: "values",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 937, col: 87, offset: 36182},
+								expr: &seqExpr{
+									pos: position{line: 937, col: 88, offset: 36183},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 937, col: 88, offset: 36183},
+											expr: &ruleRefExpr{
+												pos:  position{line: 937, col: 88, offset: 36183},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 937, col: 100, offset: 36195},
+											name: "PartitionValues",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 937, col: 118, offset: 36213},
+							label: "attrs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 937, col: 124, offset: 36219},
+								expr: &seqExpr{
+									pos: position{line: 937, col: 125, offset: 36220},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 937, col: 125, offset: 36220},
+											expr: &ruleRefExpr{
+												pos:  position{line: 937, col: 125, offset: 36220},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 937, col: 137, offset: 36232},
+											name: "PartitionAttribute",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PartitionValues",
+			pos:  position{line: 951, col: 1, offset: 36581},
+			expr: &choiceExpr{
+				pos: position{line: 951, col: 20, offset: 36600},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 951, col: 20, offset: 36600},
+						run: (*parser).callonPartitionValues2,
+						expr: &seqExpr{
+							pos: position{line: 951, col: 20, offset: 36600},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 951, col: 20, offset: 36600},
+									val:        "VALUES",
+									ignoreCase: false,
+									want:       "\"VALUES\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 951, col: 29, offset: 36609},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 951, col: 40, offset: 36620},
+									val:        "LESS",
+									ignoreCase: false,
+									want:       "\"LESS\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 951, col: 47, offset: 36627},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 951, col: 58, offset: 36638},
+									val:        "THAN",
+									ignoreCase: false,
+									want:       "\"THAN\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 951, col: 65, offset: 36645},
+									expr: &ruleRefExpr{
+										pos:  position{line: 951, col: 65, offset: 36645},
+										name: "WhiteSpace",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 951, col: 77, offset: 36657},
+									label: "vals",
+									expr: &ruleRefExpr{
+										pos:  position{line: 951, col: 82, offset: 36662},
+										name: "ParenText",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 953, col: 5, offset: 36699},
+						run: (*parser).callonPartitionValues13,
+						expr: &seqExpr{
+							pos: position{line: 953, col: 5, offset: 36699},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 953, col: 5, offset: 36699},
+									val:        "VALUES",
+									ignoreCase: false,
+									want:       "\"VALUES\"",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 953, col: 14, offset: 36708},
+									expr: &ruleRefExpr{
+										pos:  position{line: 953, col: 14, offset: 36708},
+										name: "WhiteSpace",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 953, col: 26, offset: 36720},
+									label: "vals",
+									expr: &ruleRefExpr{
+										pos:  position{line: 953, col: 31, offset: 36725},
+										name: "ParenText",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PartitionAttribute",
+			pos:  position{line: 958, col: 1, offset: 36819},
+			expr: &choiceExpr{
+				pos: position{line: 958, col: 23, offset: 36841},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 958, col: 23, offset: 36841},
+						name: "TablePhysicalKnown",
+					},
+					&actionExpr{
+						pos: position{line: 958, col: 44, offset: 36862},
+						run: (*parser).callonPartitionAttribute3,
+						expr: &choiceExpr{
+							pos: position{line: 958, col: 45, offset: 36863},
+							alternatives: []any{
+								&seqExpr{
+									pos: position{line: 958, col: 45, offset: 36863},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 958, col: 45, offset: 36863},
+											val:        "(",
+											ignoreCase: false,
+											want:       "\"(\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 958, col: 49, offset: 36867},
+											name: "ParenBody",
+										},
+										&litMatcher{
+											pos:        position{line: 958, col: 59, offset: 36877},
+											val:        ")",
+											ignoreCase: false,
+											want:       "\")\"",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 958, col: 65, offset: 36883},
+									name: "LiteralString",
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 958, col: 81, offset: 36899},
+									expr: &charClassMatcher{
+										pos:        position{line: 958, col: 81, offset: 36899},
+										val:        "[^ \\t\\r\\n;()'\",]",
+										chars:      []rune{' ', '\t', '\r', '\n', ';', '(', ')', '\'', '"', ','},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OnCommitOption",
+			pos:  position{line: 962, col: 1, offset: 37001},
+			expr: &actionExpr{
+				pos: position{line: 962, col: 19, offset: 37019},
+				run: (*parser).callonOnCommitOption1,
+				expr: &seqExpr{
+					pos: position{line: 962, col: 19, offset: 37019},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 962, col: 19, offset: 37019},
+							val:        "ON",
+							ignoreCase: false,
+							want:       "\"ON\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 962, col: 24, offset: 37024},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 962, col: 35, offset: 37035},
+							val:        "COMMIT",
+							ignoreCase: false,
+							want:       "\"COMMIT\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 962, col: 44, offset: 37044},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 962, col: 55, offset: 37055},
+							label: "action",
+							expr: &seqExpr{
+								pos: position{line: 962, col: 63, offset: 37063},
+								exprs: []any{
+									&choiceExpr{
+										pos: position{line: 962, col: 64, offset: 37064},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 962, col: 64, offset: 37064},
+												val:        "DELETE",
+												ignoreCase: false,
+												want:       "\"DELETE\"",
+											},
+											&litMatcher{
+												pos:        position{line: 962, col: 75, offset: 37075},
+												val:        "PRESERVE",
+												ignoreCase: false,
+												want:       "\"PRESERVE\"",
+											},
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 962, col: 87, offset: 37087},
+										name: "WhiteSpace",
+									},
+									&choiceExpr{
+										pos: position{line: 962, col: 99, offset: 37099},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 962, col: 99, offset: 37099},
+												val:        "ROWS",
+												ignoreCase: false,
+												want:       "\"ROWS\"",
+											},
+											&litMatcher{
+												pos:        position{line: 962, col: 108, offset: 37108},
+												val:        "DEFINITION",
+												ignoreCase: false,
+												want:       "\"DEFINITION\"",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OrganizationOption",
+			pos:  position{line: 966, col: 1, offset: 37250},
+			expr: &actionExpr{
+				pos: position{line: 966, col: 23, offset: 37272},
+				run: (*parser).callonOrganizationOption1,
+				expr: &seqExpr{
+					pos: position{line: 966, col: 23, offset: 37272},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 966, col: 23, offset: 37272},
+							val:        "ORGANIZATION",
+							ignoreCase: false,
+							want:       "\"ORGANIZATION\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 966, col: 38, offset: 37287},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 966, col: 49, offset: 37298},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 966, col: 55, offset: 37304},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 966, col: 55, offset: 37304},
+										val:        "HEAP",
+										ignoreCase: false,
+										want:       "\"HEAP\"",
+									},
+									&litMatcher{
+										pos:        position{line: 966, col: 64, offset: 37313},
+										val:        "INDEX",
+										ignoreCase: false,
+										want:       "\"INDEX\"",
+									},
+									&litMatcher{
+										pos:        position{line: 966, col: 74, offset: 37323},
+										val:        "EXTERNAL",
+										ignoreCase: false,
+										want:       "\"EXTERNAL\"",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IndexOrganizedOption",
+			pos:  position{line: 971, col: 1, offset: 37534},
+			expr: &choiceExpr{
+				pos: position{line: 971, col: 25, offset: 37558},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 971, col: 25, offset: 37558},
+						run: (*parser).callonIndexOrganizedOption2,
+						expr: &seqExpr{
+							pos: position{line: 971, col: 25, offset: 37558},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 971, col: 25, offset: 37558},
+									val:        "PCTTHRESHOLD",
+									ignoreCase: false,
+									want:       "\"PCTTHRESHOLD\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 971, col: 40, offset: 37573},
+									name: "WhiteSpace",
+								},
+								&labeledExpr{
+									pos:   position{line: 971, col: 51, offset: 37584},
+									label: "val",
+									expr: &ruleRefExpr{
+										pos:  position{line: 971, col: 55, offset: 37588},
+										name: "Digits",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 973, col: 5, offset: 37694},
+						run: (*parser).callonIndexOrganizedOption8,
+						expr: &seqExpr{
+							pos: position{line: 973, col: 5, offset: 37694},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 973, col: 5, offset: 37694},
+									val:        "OVERFLOW",
+									ignoreCase: false,
+									want:       "\"OVERFLOW\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 973, col: 16, offset: 37705},
+									label: "opts",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 973, col: 21, offset: 37710},
+										expr: &seqExpr{
+											pos: position{line: 973, col: 22, offset: 37711},
+											exprs: []any{
+												&ruleRefExpr{
+													pos:  position{line: 973, col: 22, offset: 37711},
+													name: "WhiteSpace",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 973, col: 33, offset: 37722},
+													name: "PhysicalOption",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableCompression",
+			pos:  position{line: 982, col: 1, offset: 38065},
+			expr: &actionExpr{
+				pos: position{line: 982, col: 21, offset: 38085},
+				run: (*parser).callonTableCompression1,
+				expr: &seqExpr{
+					pos: position{line: 982, col: 21, offset: 38085},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 982, col: 21, offset: 38085},
+							expr: &choiceExpr{
+								pos: position{line: 982, col: 22, offset: 38086},
+								alternatives: []any{
+									&seqExpr{
+										pos: position{line: 982, col: 22, offset: 38086},
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 982, col: 22, offset: 38086},
+												val:        "ROW",
+												ignoreCase: false,
+												want:       "\"ROW\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 982, col: 28, offset: 38092},
+												name: "WhiteSpace",
+											},
+											&litMatcher{
+												pos:        position{line: 982, col: 39, offset: 38103},
+												val:        "STORE",
+												ignoreCase: false,
+												want:       "\"STORE\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 982, col: 47, offset: 38111},
+												name: "WhiteSpace",
+											},
+										},
+									},
+									&seqExpr{
+										pos: position{line: 982, col: 60, offset: 38124},
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 982, col: 60, offset: 38124},
+												val:        "COLUMN",
+												ignoreCase: false,
+												want:       "\"COLUMN\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 982, col: 69, offset: 38133},
+												name: "WhiteSpace",
+											},
+											&litMatcher{
+												pos:        position{line: 982, col: 80, offset: 38144},
+												val:        "STORE",
+												ignoreCase: false,
+												want:       "\"STORE\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 982, col: 88, offset: 38152},
+												name: "WhiteSpace",
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 982, col: 101, offset: 38165},
+							val:        "COMPRESS",
+							ignoreCase: false,
+							want:       "\"COMPRESS\"",
+						},
+						&notExpr{
+							pos: position{line: 982, col: 112, offset: 38176},
+							expr: &seqExpr{
+								pos: position{line: 982, col: 114, offset: 38178},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 982, col: 114, offset: 38178},
+										name: "WhiteSpace",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 982, col: 125, offset: 38189},
+										name: "Digits",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 982, col: 133, offset: 38197},
+							label: "level",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 982, col: 139, offset: 38203},
+								expr: &seqExpr{
+									pos: position{line: 982, col: 140, offset: 38204},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 982, col: 140, offset: 38204},
+											name: "WhiteSpace",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 982, col: 151, offset: 38215},
+											name: "CompressionLevel",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CompressionLevel",
+			pos:  position{line: 989, col: 1, offset: 38388},
+			expr: &actionExpr{
+				pos: position{line: 989, col: 21, offset: 38408},
+				run: (*parser).callonCompressionLevel1,
+				expr: &choiceExpr{
+					pos: position{line: 989, col: 22, offset: 38409},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 989, col: 22, offset: 38409},
+							val:        "BASIC",
+							ignoreCase: false,
+							want:       "\"BASIC\"",
+						},
+						&litMatcher{
+							pos:        position{line: 989, col: 32, offset: 38419},
+							val:        "ADVANCED",
+							ignoreCase: false,
+							want:       "\"ADVANCED\"",
+						},
+						&seqExpr{
+							pos: position{line: 989, col: 45, offset: 38432},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 989, col: 45, offset: 38432},
+									val:        "FOR",
+									ignoreCase: false,
+									want:       "\"FOR\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 989, col: 51, offset: 38438},
+									name: "WhiteSpace",
+								},
+								&choiceExpr{
+									pos: position{line: 989, col: 63, offset: 38450},
+									alternatives: []any{
+										&litMatcher{
+											pos:        position{line: 989, col: 63, offset: 38450},
+											val:        "OLTP",
+											ignoreCase: false,
+											want:       "\"OLTP\"",
+										},
+										&seqExpr{
+											pos: position{line: 989, col: 72, offset: 38459},
+											exprs: []any{
+												&choiceExpr{
+													pos: position{line: 989, col: 73, offset: 38460},
+													alternatives: []any{
+														&litMatcher{
+															pos:        position{line: 989, col: 73, offset: 38460},
+															val:        "QUERY",
+															ignoreCase: false,
+															want:       "\"QUERY\"",
+														},
+														&litMatcher{
+															pos:        position{line: 989, col: 83, offset: 38470},
+															val:        "ARCHIVE",
+															ignoreCase: false,
+															want:       "\"ARCHIVE\"",
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 989, col: 94, offset: 38481},
+													expr: &seqExpr{
+														pos: position{line: 989, col: 95, offset: 38482},
+														exprs: []any{
+															&ruleRefExpr{
+																pos:  position{line: 989, col: 95, offset: 38482},
+																name: "WhiteSpace",
+															},
+															&choiceExpr{
+																pos: position{line: 989, col: 107, offset: 38494},
+																alternatives: []any{
+																	&litMatcher{
+																		pos:        position{line: 989, col: 107, offset: 38494},
+																		val:        "LOW",
+																		ignoreCase: false,
+																		want:       "\"LOW\"",
+																	},
+																	&litMatcher{
+																		pos:        position{line: 989, col: 115, offset: 38502},
+																		val:        "HIGH",
+																		ignoreCase: false,
+																		want:       "\"HIGH\"",
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										&seqExpr{
+											pos: position{line: 989, col: 127, offset: 38514},
+											exprs: []any{
+												&choiceExpr{
+													pos: position{line: 989, col: 128, offset: 38515},
+													alternatives: []any{
+														&litMatcher{
+															pos:        position{line: 989, col: 128, offset: 38515},
+															val:        "ALL",
+															ignoreCase: false,
+															want:       "\"ALL\"",
+														},
+														&litMatcher{
+															pos:        position{line: 989, col: 136, offset: 38523},
+															val:        "DIRECT_LOAD",
+															ignoreCase: false,
+															want:       "\"DIRECT_LOAD\"",
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 989, col: 151, offset: 38538},
+													name: "WhiteSpace",
+												},
+												&litMatcher{
+													pos:        position{line: 989, col: 162, offset: 38549},
+													val:        "OPERATIONS",
+													ignoreCase: false,
+													want:       "\"OPERATIONS\"",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableFlagOption",
+			pos:  position{line: 993, col: 1, offset: 38637},
+			expr: &actionExpr{
+				pos: position{line: 993, col: 20, offset: 38656},
+				run: (*parser).callonTableFlagOption1,
+				expr: &choiceExpr{
+					pos: position{line: 993, col: 21, offset: 38657},
+					alternatives: []any{
+						&seqExpr{
+							pos: position{line: 993, col: 21, offset: 38657},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 993, col: 21, offset: 38657},
+									val:        "SEGMENT",
+									ignoreCase: false,
+									want:       "\"SEGMENT\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 993, col: 31, offset: 38667},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 993, col: 42, offset: 38678},
+									val:        "CREATION",
+									ignoreCase: false,
+									want:       "\"CREATION\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 993, col: 53, offset: 38689},
+									name: "WhiteSpace",
+								},
+								&choiceExpr{
+									pos: position{line: 993, col: 65, offset: 38701},
+									alternatives: []any{
+										&litMatcher{
+											pos:        position{line: 993, col: 65, offset: 38701},
+											val:        "IMMEDIATE",
+											ignoreCase: false,
+											want:       "\"IMMEDIATE\"",
+										},
+										&litMatcher{
+											pos:        position{line: 993, col: 79, offset: 38715},
+											val:        "DEFERRED",
+											ignoreCase: false,
+											want:       "\"DEFERRED\"",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 993, col: 93, offset: 38729},
+							val:        "NOCACHE",
+							ignoreCase: false,
+							want:       "\"NOCACHE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 993, col: 105, offset: 38741},
+							val:        "CACHE",
+							ignoreCase: false,
+							want:       "\"CACHE\"",
+						},
+						&litMatcher{
+							pos:        position{line: 993, col: 115, offset: 38751},
+							val:        "NOMONITORING",
+							ignoreCase: false,
+							want:       "\"NOMONITORING\"",
+						},
+						&litMatcher{
+							pos:        position{line: 993, col: 132, offset: 38768},
+							val:        "MONITORING",
+							ignoreCase: false,
+							want:       "\"MONITORING\"",
+						},
+						&litMatcher{
+							pos:        position{line: 993, col: 147, offset: 38783},
+							val:        "NOROWDEPENDENCIES",
+							ignoreCase: false,
+							want:       "\"NOROWDEPENDENCIES\"",
+						},
+						&litMatcher{
+							pos:        position{line: 993, col: 169, offset: 38805},
+							val:        "ROWDEPENDENCIES",
+							ignoreCase: false,
+							want:       "\"ROWDEPENDENCIES\"",
+						},
+						&seqExpr{
+							pos: position{line: 993, col: 189, offset: 38825},
+							exprs: []any{
+								&choiceExpr{
+									pos: position{line: 993, col: 190, offset: 38826},
+									alternatives: []any{
+										&litMatcher{
+											pos:        position{line: 993, col: 190, offset: 38826},
+											val:        "ENABLE",
+											ignoreCase: false,
+											want:       "\"ENABLE\"",
+										},
+										&litMatcher{
+											pos:        position{line: 993, col: 201, offset: 38837},
+											val:        "DISABLE",
+											ignoreCase: false,
+											want:       "\"DISABLE\"",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 993, col: 212, offset: 38848},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 993, col: 223, offset: 38859},
+									val:        "ROW",
+									ignoreCase: false,
+									want:       "\"ROW\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 993, col: 229, offset: 38865},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 993, col: 240, offset: 38876},
+									val:        "MOVEMENT",
+									ignoreCase: false,
+									want:       "\"MOVEMENT\"",
+								},
+							},
+						},
+						&seqExpr{
+							pos: position{line: 993, col: 253, offset: 38889},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 993, col: 253, offset: 38889},
+									val:        "NO",
+									ignoreCase: false,
+									want:       "\"NO\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 993, col: 258, offset: 38894},
+									name: "WhiteSpace",
+								},
+								&litMatcher{
+									pos:        position{line: 993, col: 269, offset: 38905},
+									val:        "INMEMORY",
+									ignoreCase: false,
+									want:       "\"INMEMORY\"",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 993, col: 282, offset: 38918},
+							val:        "INMEMORY",
+							ignoreCase: false,
+							want:       "\"INMEMORY\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LobStorage",
+			pos:  position{line: 997, col: 1, offset: 39033},
+			expr: &actionExpr{
+				pos: position{line: 997, col: 15, offset: 39047},
+				run: (*parser).callonLobStorage1,
+				expr: &seqExpr{
+					pos: position{line: 997, col: 15, offset: 39047},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 997, col: 15, offset: 39047},
+							val:        "LOB",
+							ignoreCase: false,
+							want:       "\"LOB\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 997, col: 21, offset: 39053},
+							expr: &ruleRefExpr{
+								pos:  position{line: 997, col: 21, offset: 39053},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 997, col: 33, offset: 39065},
+							label: "cols",
+							expr: &ruleRefExpr{
+								pos:  position{line: 997, col: 38, offset: 39070},
+								name: "ColumnList",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 997, col: 49, offset: 39081},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 997, col: 60, offset: 39092},
+							val:        "STORE",
+							ignoreCase: false,
+							want:       "\"STORE\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 997, col: 68, offset: 39100},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 997, col: 79, offset: 39111},
+							val:        "AS",
+							ignoreCase: false,
+							want:       "\"AS\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 997, col: 84, offset: 39116},
+							label: "kind",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 997, col: 89, offset: 39121},
+								expr: &seqExpr{
+									pos: position{line: 997, col: 90, offset: 39122},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 997, col: 90, offset: 39122},
+											name: "WhiteSpace",
+										},
+										&choiceExpr{
+											pos: position{line: 997, col: 102, offset: 39134},
+											alternatives: []any{
+												&litMatcher{
+													pos:        position{line: 997, col: 102, offset: 39134},
+													val:        "SECUREFILE",
+													ignoreCase: false,
+													want:       "\"SECUREFILE\"",
+												},
+												&litMatcher{
+													pos:        position{line: 997, col: 117, offset: 39149},
+													val:        "BASICFILE",
+													ignoreCase: false,
+													want:       "\"BASICFILE\"",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 997, col: 132, offset: 39164},
+							label: "seg",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 997, col: 136, offset: 39168},
+								expr: &seqExpr{
+									pos: position{line: 997, col: 137, offset: 39169},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 997, col: 137, offset: 39169},
+											name: "WhiteSpace",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 997, col: 148, offset: 39180},
+											name: "TableNamePart",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 997, col: 164, offset: 39196},
+							label: "params",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 997, col: 171, offset: 39203},
+								expr: &seqExpr{
+									pos: position{line: 997, col: 172, offset: 39204},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 997, col: 172, offset: 39204},
+											expr: &ruleRefExpr{
+												pos:  position{line: 997, col: 172, offset: 39204},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 997, col: 184, offset: 39216},
+											name: "LobParameters",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LobParameters",
+			pos:  position{line: 1025, col: 1, offset: 39959},
+			expr: &actionExpr{
+				pos: position{line: 1025, col: 18, offset: 39976},
+				run: (*parser).callonLobParameters1,
+				expr: &seqExpr{
+					pos: position{line: 1025, col: 18, offset: 39976},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1025, col: 18, offset: 39976},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 1025, col: 22, offset: 39980},
+							label: "items",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1025, col: 28, offset: 39986},
+								expr: &seqExpr{
+									pos: position{line: 1025, col: 29, offset: 39987},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 1025, col: 29, offset: 39987},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1025, col: 29, offset: 39987},
+												name: "WhiteSpace",
+											},
+										},
+										&choiceExpr{
+											pos: position{line: 1025, col: 42, offset: 40000},
+											alternatives: []any{
+												&ruleRefExpr{
+													pos:  position{line: 1025, col: 42, offset: 40000},
+													name: "TablespaceOption",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 1025, col: 61, offset: 40019},
+													name: "StorageOption",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 1025, col: 77, offset: 40035},
+													name: "UnparsedToken",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1025, col: 94, offset: 40052},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1025, col: 94, offset: 40052},
+								name: "WhiteSpace",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1025, col: 106, offset: 40064},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "UnparsedToken",
+			pos:  position{line: 1030, col: 1, offset: 40183},
+			expr: &actionExpr{
+				pos: position{line: 1030, col: 18, offset: 40200},
+				run: (*parser).callonUnparsedToken1,
+				expr: &choiceExpr{
+					pos: position{line: 1030, col: 19, offset: 40201},
+					alternatives: []any{
+						&seqExpr{
+							pos: position{line: 1030, col: 19, offset: 40201},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 1030, col: 19, offset: 40201},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1030, col: 23, offset: 40205},
+									name: "ParenBody",
+								},
+								&litMatcher{
+									pos:        position{line: 1030, col: 33, offset: 40215},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1030, col: 39, offset: 40221},
+							name: "LiteralString",
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 1030, col: 55, offset: 40237},
+							expr: &charClassMatcher{
+								pos:        position{line: 1030, col: 55, offset: 40237},
+								val:        "[^ \\t\\r\\n;()'\"]",
+								chars:      []rune{' ', '\t', '\r', '\n', ';', '(', ')', '\'', '"'},
+								ignoreCase: false,
+								inverted:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableBodySelect",
+			pos:  position{line: 1035, col: 1, offset: 40437},
+			expr: &actionExpr{
+				pos: position{line: 1035, col: 20, offset: 40456},
+				run: (*parser).callonTableBodySelect1,
+				expr: &seqExpr{
+					pos: position{line: 1035, col: 20, offset: 40456},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 1035, col: 20, offset: 40456},
+							label: "cols",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1035, col: 25, offset: 40461},
+								expr: &seqExpr{
+									pos: position{line: 1035, col: 26, offset: 40462},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 1035, col: 26, offset: 40462},
+											name: "ColumnList",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 1035, col: 37, offset: 40473},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1035, col: 37, offset: 40473},
+												name: "WhiteSpace",
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1035, col: 51, offset: 40487},
+							label: "physical",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1035, col: 60, offset: 40496},
+								expr: &seqExpr{
+									pos: position{line: 1035, col: 61, offset: 40497},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 1035, col: 61, offset: 40497},
+											name: "TablePhysicalKnown",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1035, col: 80, offset: 40516},
+											name: "WhiteSpace",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1035, col: 93, offset: 40529},
+							val:        "AS",
+							ignoreCase: false,
+							want:       "\"AS\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1035, col: 98, offset: 40534},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 1035, col: 109, offset: 40545},
+							label: "query",
+							expr: &choiceExpr{
+								pos: position{line: 1035, col: 116, offset: 40552},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 1035, col: 116, offset: 40552},
+										name: "SelectParsed",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1035, col: 131, offset: 40567},
+										name: "SelectText",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectParsed",
+			pos:  position{line: 1044, col: 1, offset: 40775},
+			expr: &actionExpr{
+				pos: position{line: 1044, col: 17, offset: 40791},
+				run: (*parser).callonSelectParsed1,
+				expr: &labeledExpr{
+					pos:   position{line: 1044, col: 17, offset: 40791},
+					label: "q",
+					expr: &ruleRefExpr{
+						pos:  position{line: 1044, col: 19, offset: 40793},
+						name: "SelectQuery",
+					},
+				},
+			},
+		},
+		{
+			name: "SelectText",
+			pos:  position{line: 1047, col: 1, offset: 40929},
+			expr: &actionExpr{
+				pos: position{line: 1047, col: 15, offset: 40943},
+				run: (*parser).callonSelectText1,
+				expr: &labeledExpr{
+					pos:   position{line: 1047, col: 15, offset: 40943},
+					label: "q",
+					expr: &ruleRefExpr{
+						pos:  position{line: 1047, col: 17, offset: 40945},
+						name: "SelectStatement",
+					},
+				},
+			},
+		},
+		{
+			name: "SelectQuery",
+			pos:  position{line: 1052, col: 1, offset: 41136},
+			expr: &actionExpr{
+				pos: position{line: 1052, col: 16, offset: 41151},
+				run: (*parser).callonSelectQuery1,
+				expr: &seqExpr{
+					pos: position{line: 1052, col: 16, offset: 41151},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1052, col: 16, offset: 41151},
+							val:        "SELECT",
+							ignoreCase: false,
+							want:       "\"SELECT\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1052, col: 25, offset: 41160},
+							name: "WhiteSpace",
+						},
+						&labeledExpr{
+							pos:   position{line: 1052, col: 36, offset: 41171},
+							label: "distinct",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1052, col: 45, offset: 41180},
+								expr: &seqExpr{
+									pos: position{line: 1052, col: 46, offset: 41181},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 1052, col: 46, offset: 41181},
+											val:        "distinct",
+											ignoreCase: true,
+											want:       "\"DISTINCT\"i",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1052, col: 58, offset: 41193},
+											name: "WhiteSpace",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1052, col: 71, offset: 41206},
+							label: "items",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1052, col: 77, offset: 41212},
+								name: "SelectItems",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1052, col: 89, offset: 41224},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 1052, col: 100, offset: 41235},
+							val:        "from",
+							ignoreCase: true,
+							want:       "\"FROM\"i",
+						},
+						&notExpr{
+							pos: position{line: 1052, col: 108, offset: 41243},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1052, col: 109, offset: 41244},
+								name: "IdentifierChar",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1052, col: 124, offset: 41259},
+							label: "from",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1052, col: 129, offset: 41264},
+								name: "SelectFrom",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1052, col: 140, offset: 41275},
+							label: "where",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1052, col: 146, offset: 41281},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1052, col: 146, offset: 41281},
+									name: "SelectWhere",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1052, col: 159, offset: 41294},
+							label: "rest",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1052, col: 164, offset: 41299},
+								name: "SelectRest",
+							},
+						},
+						&andExpr{
+							pos: position{line: 1052, col: 175, offset: 41310},
+							expr: &seqExpr{
+								pos: position{line: 1052, col: 177, offset: 41312},
+								exprs: []any{
+									&zeroOrOneExpr{
+										pos: position{line: 1052, col: 177, offset: 41312},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1052, col: 177, offset: 41312},
+											name: "WhiteSpace",
+										},
+									},
+									&litMatcher{
+										pos:        position{line: 1052, col: 189, offset: 41324},
+										val:        ";",
+										ignoreCase: false,
+										want:       "\";\"",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectItems",
+			pos:  position{line: 1065, col: 1, offset: 41610},
+			expr: &actionExpr{
+				pos: position{line: 1065, col: 16, offset: 41625},
+				run: (*parser).callonSelectItems1,
+				expr: &seqExpr{
+					pos: position{line: 1065, col: 16, offset: 41625},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 1065, col: 16, offset: 41625},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1065, col: 22, offset: 41631},
+								name: "SelectItem",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1065, col: 33, offset: 41642},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1065, col: 38, offset: 41647},
+								expr: &seqExpr{
+									pos: position{line: 1065, col: 39, offset: 41648},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 1065, col: 39, offset: 41648},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1065, col: 39, offset: 41648},
+												name: "WhiteSpace",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 1065, col: 51, offset: 41660},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 1065, col: 55, offset: 41664},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1065, col: 55, offset: 41664},
+												name: "WhiteSpace",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1065, col: 67, offset: 41676},
+											name: "SelectItem",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectItem",
+			pos:  position{line: 1072, col: 1, offset: 41891},
+			expr: &choiceExpr{
+				pos: position{line: 1072, col: 15, offset: 41905},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 1072, col: 15, offset: 41905},
+						run: (*parser).callonSelectItem2,
+						expr: &seqExpr{
+							pos: position{line: 1072, col: 15, offset: 41905},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 1072, col: 15, offset: 41905},
+									expr: &seqExpr{
+										pos: position{line: 1072, col: 16, offset: 41906},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 1072, col: 16, offset: 41906},
+												name: "ExprName",
+											},
+											&litMatcher{
+												pos:        position{line: 1072, col: 25, offset: 41915},
+												val:        ".",
+												ignoreCase: false,
+												want:       "\".\"",
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1072, col: 31, offset: 41921},
+									val:        "*",
+									ignoreCase: false,
+									want:       "\"*\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1074, col: 5, offset: 41989},
+						run: (*parser).callonSelectItem9,
+						expr: &seqExpr{
+							pos: position{line: 1074, col: 5, offset: 41989},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 1074, col: 5, offset: 41989},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1074, col: 7, offset: 41991},
+										name: "ExpressionTree",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 1074, col: 22, offset: 42006},
+									label: "alias",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 1074, col: 28, offset: 42012},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1074, col: 28, offset: 42012},
+											name: "SelectAlias",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectAlias",
+			pos:  position{line: 1082, col: 1, offset: 42210},
+			expr: &actionExpr{
+				pos: position{line: 1082, col: 16, offset: 42225},
+				run: (*parser).callonSelectAlias1,
+				expr: &seqExpr{
+					pos: position{line: 1082, col: 16, offset: 42225},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 1082, col: 16, offset: 42225},
+							expr: &seqExpr{
+								pos: position{line: 1082, col: 17, offset: 42226},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 1082, col: 17, offset: 42226},
+										name: "WhiteSpace",
+									},
+									&litMatcher{
+										pos:        position{line: 1082, col: 28, offset: 42237},
+										val:        "as",
+										ignoreCase: true,
+										want:       "\"AS\"i",
+									},
+									&notExpr{
+										pos: position{line: 1082, col: 34, offset: 42243},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1082, col: 35, offset: 42244},
+											name: "IdentifierChar",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1082, col: 52, offset: 42261},
+							name: "WhiteSpace",
+						},
+						&notExpr{
+							pos: position{line: 1082, col: 63, offset: 42272},
+							expr: &seqExpr{
+								pos: position{line: 1082, col: 65, offset: 42274},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 1082, col: 65, offset: 42274},
+										val:        "from",
+										ignoreCase: true,
+										want:       "\"FROM\"i",
+									},
+									&notExpr{
+										pos: position{line: 1082, col: 73, offset: 42282},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1082, col: 74, offset: 42283},
+											name: "IdentifierChar",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1082, col: 90, offset: 42299},
+							label: "alias",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1082, col: 96, offset: 42305},
+								name: "ExprNamePart",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectFrom",
+			pos:  position{line: 1085, col: 1, offset: 42345},
+			expr: &actionExpr{
+				pos: position{line: 1085, col: 15, offset: 42359},
+				run: (*parser).callonSelectFrom1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1085, col: 15, offset: 42359},
+					expr: &seqExpr{
+						pos: position{line: 1085, col: 16, offset: 42360},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 1085, col: 16, offset: 42360},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1085, col: 17, offset: 42361},
+									name: "SelectFromEnd",
+								},
+							},
+							&choiceExpr{
+								pos: position{line: 1085, col: 32, offset: 42376},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 1085, col: 32, offset: 42376},
+										name: "LiteralString",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1085, col: 48, offset: 42392},
+										name: "ParenText",
+									},
+									&anyMatcher{
+										line: 1085, col: 60, offset: 42404,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectFromEnd",
+			pos:  position{line: 1088, col: 1, offset: 42464},
+			expr: &choiceExpr{
+				pos: position{line: 1088, col: 18, offset: 42481},
+				alternatives: []any{
+					&seqExpr{
+						pos: position{line: 1088, col: 18, offset: 42481},
+						exprs: []any{
+							&zeroOrOneExpr{
+								pos: position{line: 1088, col: 18, offset: 42481},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1088, col: 18, offset: 42481},
+									name: "WhiteSpace",
+								},
+							},
+							&litMatcher{
+								pos:        position{line: 1088, col: 30, offset: 42493},
+								val:        ";",
+								ignoreCase: false,
+								want:       "\";\"",
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 1088, col: 36, offset: 42499},
+						exprs: []any{
+							&ruleRefExpr{
+								pos:  position{line: 1088, col: 36, offset: 42499},
+								name: "WhiteSpace",
+							},
+							&choiceExpr{
+								pos: position{line: 1088, col: 48, offset: 42511},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 1088, col: 48, offset: 42511},
+										val:        "where",
+										ignoreCase: true,
+										want:       "\"WHERE\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 1088, col: 59, offset: 42522},
+										val:        "group",
+										ignoreCase: true,
+										want:       "\"GROUP\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 1088, col: 70, offset: 42533},
+										val:        "order",
+										ignoreCase: true,
+										want:       "\"ORDER\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 1088, col: 81, offset: 42544},
+										val:        "having",
+										ignoreCase: true,
+										want:       "\"HAVING\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 1088, col: 93, offset: 42556},
+										val:        "connect",
+										ignoreCase: true,
+										want:       "\"CONNECT\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 1088, col: 106, offset: 42569},
+										val:        "start",
+										ignoreCase: true,
+										want:       "\"START\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 1088, col: 117, offset: 42580},
+										val:        "union",
+										ignoreCase: true,
+										want:       "\"UNION\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 1088, col: 128, offset: 42591},
+										val:        "minus",
+										ignoreCase: true,
+										want:       "\"MINUS\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 1088, col: 139, offset: 42602},
+										val:        "intersect",
+										ignoreCase: true,
+										want:       "\"INTERSECT\"i",
+									},
+								},
+							},
+							&notExpr{
+								pos: position{line: 1088, col: 153, offset: 42616},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1088, col: 154, offset: 42617},
+									name: "IdentifierChar",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectWhere",
+			pos:  position{line: 1089, col: 1, offset: 42633},
+			expr: &actionExpr{
+				pos: position{line: 1089, col: 16, offset: 42648},
+				run: (*parser).callonSelectWhere1,
+				expr: &seqExpr{
+					pos: position{line: 1089, col: 16, offset: 42648},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 1089, col: 16, offset: 42648},
+							name: "WhiteSpace",
+						},
+						&litMatcher{
+							pos:        position{line: 1089, col: 27, offset: 42659},
+							val:        "where",
+							ignoreCase: true,
+							want:       "\"WHERE\"i",
+						},
+						&notExpr{
+							pos: position{line: 1089, col: 36, offset: 42668},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1089, col: 37, offset: 42669},
+								name: "IdentifierChar",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1089, col: 52, offset: 42684},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1089, col: 52, offset: 42684},
+								name: "WhiteSpace",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1089, col: 64, offset: 42696},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1089, col: 66, offset: 42698},
+								name: "ExpressionTree",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectRest",
+			pos:  position{line: 1092, col: 1, offset: 42736},
+			expr: &actionExpr{
+				pos: position{line: 1092, col: 15, offset: 42750},
+				run: (*parser).callonSelectRest1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 1092, col: 15, offset: 42750},
+					expr: &choiceExpr{
+						pos: position{line: 1092, col: 16, offset: 42751},
+						alternatives: []any{
+							&ruleRefExpr{
+								pos:  position{line: 1092, col: 16, offset: 42751},
+								name: "LiteralString",
+							},
+							&seqExpr{
+								pos: position{line: 1092, col: 32, offset: 42767},
+								exprs: []any{
+									&notExpr{
+										pos: position{line: 1092, col: 32, offset: 42767},
+										expr: &litMatcher{
+											pos:        position{line: 1092, col: 33, offset: 42768},
+											val:        ";",
+											ignoreCase: false,
+											want:       "\";\"",
+										},
+									},
+									&anyMatcher{
+										line: 1092, col: 37, offset: 42772,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SelectStatement",
+			pos:  position{line: 1097, col: 1, offset: 42898},
+			expr: &actionExpr{
+				pos: position{line: 1097, col: 20, offset: 42917},
+				run: (*parser).callonSelectStatement1,
+				expr: &seqExpr{
+					pos: position{line: 1097, col: 20, offset: 42917},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 1097, col: 21, offset: 42918},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 1097, col: 21, offset: 42918},
+									val:        "SELECT",
+									ignoreCase: false,
+									want:       "\"SELECT\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1097, col: 32, offset: 42929},
+									val:        "WITH",
+									ignoreCase: false,
+									want:       "\"WITH\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1097, col: 41, offset: 42938},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1097, col: 46, offset: 42943},
+							expr: &choiceExpr{
+								pos: position{line: 1097, col: 47, offset: 42944},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 1097, col: 47, offset: 42944},
+										name: "LiteralString",
+									},
+									&seqExpr{
+										pos: position{line: 1097, col: 63, offset: 42960},
+										exprs: []any{
+											&notExpr{
+												pos: position{line: 1097, col: 63, offset: 42960},
+												expr: &litMatcher{
+													pos:        position{line: 1097, col: 64, offset: 42961},
+													val:        ";",
+													ignoreCase: false,
+													want:       "\";\"",
+												},
+											},
+											&anyMatcher{
+												line: 1097, col: 68, offset: 42965,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ColumnName",
+			pos:  position{line: 1101, col: 1, offset: 43026},
+			expr: &ruleRefExpr{
+				pos:  position{line: 1101, col: 15, offset: 43040},
+				name: "LiteralString",
+			},
+		},
+		{
+			name: "Identifier",
+			pos:  position{line: 1103, col: 1, offset: 43057},
+			expr: &seqExpr{
+				pos: position{line: 1103, col: 15, offset: 43071},
+				exprs: []any{
+					&charClassMatcher{
+						pos:        position{line: 1103, col: 15, offset: 43071},
+						val:        "[a-zA-Z_]",
+						chars:      []rune{'_'},
+						ranges:     []rune{'a', 'z', 'A', 'Z'},
+						ignoreCase: false,
+						inverted:   false,
+					},
+					&oneOrMoreExpr{
+						pos: position{line: 1103, col: 24, offset: 43080},
+						expr: &charClassMatcher{
+							pos:        position{line: 1103, col: 24, offset: 43080},
+							val:        "[a-zA-Z0-9_]",
+							chars:      []rune{'_'},
+							ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralValue",
+			pos:  position{line: 1105, col: 1, offset: 43097},
+			expr: &choiceExpr{
+				pos: position{line: 1105, col: 17, offset: 43113},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 1105, col: 17, offset: 43113},
+						name: "LiteralString",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1105, col: 33, offset: 43129},
+						name: "LiteralNumber",
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralNumber",
+			pos:  position{line: 1107, col: 1, offset: 43146},
+			expr: &actionExpr{
+				pos: position{line: 1107, col: 18, offset: 43163},
+				run: (*parser).callonLiteralNumber1,
+				expr: &seqExpr{
+					pos: position{line: 1107, col: 18, offset: 43163},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 1107, col: 18, offset: 43163},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1107, col: 18, offset: 43163},
+								name: "Sign",
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 1107, col: 25, offset: 43170},
+							alternatives: []any{
+								&ruleRefExpr{
+									pos:  position{line: 1107, col: 25, offset: 43170},
+									name: "Float",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1107, col: 33, offset: 43178},
+									name: "Integer",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Sign",
+			pos:  position{line: 1110, col: 1, offset: 43223},
+			expr: &charClassMatcher{
+				pos:        position{line: 1110, col: 9, offset: 43231},
+				val:        "[+-]",
+				chars:      []rune{'+', '-'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "Float",
+			pos:  position{line: 1111, col: 1, offset: 43237},
+			expr: &choiceExpr{
+				pos: position{line: 1111, col: 10, offset: 43246},
+				alternatives: []any{
+					&seqExpr{
+						pos: position{line: 1111, col: 10, offset: 43246},
+						exprs: []any{
+							&zeroOrOneExpr{
+								pos: position{line: 1111, col: 10, offset: 43246},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1111, col: 10, offset: 43246},
+									name: "Digits",
+								},
+							},
+							&litMatcher{
+								pos:        position{line: 1111, col: 18, offset: 43254},
+								val:        ".",
+								ignoreCase: false,
+								want:       "\".\"",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1111, col: 22, offset: 43258},
+								name: "Digits",
+							},
+							&zeroOrOneExpr{
+								pos: position{line: 1111, col: 29, offset: 43265},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1111, col: 30, offset: 43266},
+									name: "ExponentPart",
+								},
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 1111, col: 47, offset: 43283},
+						exprs: []any{
+							&ruleRefExpr{
+								pos:  position{line: 1111, col: 47, offset: 43283},
+								name: "Digits",
+							},
+							&litMatcher{
+								pos:        position{line: 1111, col: 54, offset: 43290},
+								val:        ".",
+								ignoreCase: false,
+								want:       "\".\"",
+							},
+							&zeroOrOneExpr{
+								pos: position{line: 1111, col: 58, offset: 43294},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1111, col: 59, offset: 43295},
+									name: "ExponentPart",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Integer",
+			pos:  position{line: 1112, col: 1, offset: 43311},
+			expr: &seqExpr{
+				pos: position{line: 1112, col: 12, offset: 43322},
+				exprs: []any{
+					&ruleRefExpr{
+						pos:  position{line: 1112, col: 12, offset: 43322},
+						name: "Digits",
+					},
+					&zeroOrOneExpr{
+						pos: position{line: 1112, col: 19, offset: 43329},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1112, col: 20, offset: 43330},
+							name: "ExponentPart",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExponentPart",
+			pos:  position{line: 1113, col: 1, offset: 43346},
+			expr: &seqExpr{
+				pos: position{line: 1113, col: 17, offset: 43362},
+				exprs: []any{
+					&charClassMatcher{
+						pos:        position{line: 1113, col: 17, offset: 43362},
+						val:        "[eE]",
+						chars:      []rune{'e', 'E'},
+						ignoreCase: false,
+						inverted:   false,
+					},
+					&zeroOrOneExpr{
+						pos: position{line: 1113, col: 22, offset: 43367},
+						expr: &charClassMatcher{
+							pos:        position{line: 1113, col: 22, offset: 43367},
+							val:        "[+-]",
+							chars:      []rune{'+', '-'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1113, col: 28, offset: 43373},
+						name: "Digits",
+					},
+				},
+			},
+		},
+		{
+			name: "Digits",
+			pos:  position{line: 1114, col: 1, offset: 43381},
+			expr: &actionExpr{
+				pos: position{line: 1114, col: 11, offset: 43391},
+				run: (*parser).callonDigits1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1114, col: 11, offset: 43391},
+					expr: &charClassMatcher{
+						pos:        position{line: 1114, col: 11, offset: 43391},
+						val:        "[0-9]",
+						ranges:     []rune{'0', '9'},
+						ignoreCase: false,
+						inverted:   false,
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralString",
+			pos:  position{line: 1123, col: 1, offset: 43539},
+			expr: &choiceExpr{
+				pos: position{line: 1123, col: 18, offset: 43556},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 1123, col: 18, offset: 43556},
+						name: "LiteralStringSingleQuote",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1123, col: 45, offset: 43583},
+						name: "LiteralStringDoubleQuote",
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralStringSingleQuote",
+			pos:  position{line: 1124, col: 1, offset: 43609},
+			expr: &actionExpr{
+				pos: position{line: 1124, col: 29, offset: 43637},
+				run: (*parser).callonLiteralStringSingleQuote1,
+				expr: &seqExpr{
+					pos: position{line: 1124, col: 29, offset: 43637},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1124, col: 29, offset: 43637},
+							val:        "'",
+							ignoreCase: false,
+							want:       "\"'\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1124, col: 35, offset: 43643},
+							expr: &choiceExpr{
+								pos: position{line: 1124, col: 36, offset: 43644},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 1124, col: 36, offset: 43644},
+										val:        "''",
+										ignoreCase: false,
+										want:       "\"''\"",
+									},
+									&seqExpr{
+										pos: position{line: 1124, col: 43, offset: 43651},
+										exprs: []any{
+											&notExpr{
+												pos: position{line: 1124, col: 43, offset: 43651},
+												expr: &litMatcher{
+													pos:        position{line: 1124, col: 44, offset: 43652},
+													val:        "'",
+													ignoreCase: false,
+													want:       "\"'\"",
+												},
+											},
+											&anyMatcher{
+												line: 1124, col: 49, offset: 43657,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1124, col: 54, offset: 43662},
+							val:        "'",
+							ignoreCase: false,
+							want:       "\"'\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralStringDoubleQuote",
+			pos:  position{line: 1132, col: 1, offset: 43875},
+			expr: &actionExpr{
+				pos: position{line: 1132, col: 29, offset: 43903},
+				run: (*parser).callonLiteralStringDoubleQuote1,
+				expr: &seqExpr{
+					pos: position{line: 1132, col: 29, offset: 43903},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1132, col: 29, offset: 43903},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1132, col: 33, offset: 43907},
+							expr: &seqExpr{
+								pos: position{line: 1132, col: 34, offset: 43908},
+								exprs: []any{
+									&notExpr{
+										pos: position{line: 1132, col: 34, offset: 43908},
+										expr: &litMatcher{
+											pos:        position{line: 1132, col: 35, offset: 43909},
+											val:        "\"",
+											ignoreCase: false,
+											want:       "\"\\\"\"",
+										},
+									},
+									&anyMatcher{
+										line: 1132, col: 39, offset: 43913,
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1132, col: 43, offset: 43917},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WhiteSpace",
+			pos:  position{line: 1137, col: 1, offset: 43996},
+			expr: &oneOrMoreExpr{
+				pos: position{line: 1137, col: 15, offset: 44010},
+				expr: &choiceExpr{
+					pos: position{line: 1137, col: 16, offset: 44011},
+					alternatives: []any{
+						&ruleRefExpr{
+							pos:  position{line: 1137, col: 16, offset: 44011},
+							name: "Spaces",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1137, col: 25, offset: 44020},
+							name: "NewLines",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1137, col: 36, offset: 44031},
+							name: "LineComment",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1137, col: 50, offset: 44045},
+							name: "BlockComment",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Spaces",
+			pos:  position{line: 1138, col: 1, offset: 44061},
+			expr: &actionExpr{
+				pos: position{line: 1138, col: 11, offset: 44071},
+				run: (*parser).callonSpaces1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1138, col: 11, offset: 44071},
+					expr: &ruleRefExpr{
+						pos:  position{line: 1138, col: 11, offset: 44071},
+						name: "Space",
+					},
+				},
+			},
+		},
+		{
+			name: "Space",
+			pos:  position{line: 1141, col: 1, offset: 44103},
+			expr: &charClassMatcher{
+				pos:        position{line: 1141, col: 10, offset: 44112},
+				val:        "[ \\t]",
+				chars:      []rune{' ', '\t'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "NewLines",
+			pos:  position{line: 1142, col: 1, offset: 44119},
+			expr: &actionExpr{
+				pos: position{line: 1142, col: 13, offset: 44131},
+				run: (*parser).callonNewLines1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 1142, col: 13, offset: 44131},
+					expr: &ruleRefExpr{
+						pos:  position{line: 1142, col: 13, offset: 44131},
+						name: "NewLine",
+					},
+				},
+			},
+		},
+		{
+			name: "NewLine",
+			pos:  position{line: 1145, col: 1, offset: 44165},
+			expr: &charClassMatcher{
+				pos:        position{line: 1145, col: 12, offset: 44176},
+				val:        "[ \\r\\n]",
+				chars:      []rune{' ', '\r', '\n'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "LineComment",
+			pos:  position{line: 1146, col: 1, offset: 44185},
+			expr: &actionExpr{
+				pos: position{line: 1146, col: 16, offset: 44200},
+				run: (*parser).callonLineComment1,
+				expr: &seqExpr{
+					pos: position{line: 1146, col: 16, offset: 44200},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1146, col: 16, offset: 44200},
+							val:        "--",
+							ignoreCase: false,
+							want:       "\"--\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1146, col: 21, offset: 44205},
+							expr: &seqExpr{
+								pos: position{line: 1146, col: 22, offset: 44206},
+								exprs: []any{
+									&notExpr{
+										pos: position{line: 1146, col: 22, offset: 44206},
+										expr: &charClassMatcher{
+											pos:        position{line: 1146, col: 23, offset: 44207},
+											val:        "[\\r\\n]",
+											chars:      []rune{'\r', '\n'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+									&anyMatcher{
+										line: 1146, col: 30, offset: 44214,
+									},
+								},
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 1146, col: 35, offset: 44219},
+							alternatives: []any{
+								&seqExpr{
+									pos: position{line: 1146, col: 35, offset: 44219},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 1146, col: 35, offset: 44219},
+											expr: &litMatcher{
+												pos:        position{line: 1146, col: 35, offset: 44219},
+												val:        "\r",
+												ignoreCase: false,
+												want:       "\"\\r\"",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 1146, col: 41, offset: 44225},
+											val:        "\n",
+											ignoreCase: false,
+											want:       "\"\\n\"",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1146, col: 48, offset: 44232},
+									name: "EOF",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BlockComment",
+			pos:  position{line: 1149, col: 1, offset: 44261},
+			expr: &actionExpr{
+				pos: position{line: 1149, col: 17, offset: 44277},
+				run: (*parser).callonBlockComment1,
+				expr: &seqExpr{
+					pos: position{line: 1149, col: 17, offset: 44277},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1149, col: 17, offset: 44277},
+							val:        "/*",
+							ignoreCase: false,
+							want:       "\"/*\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1149, col: 22, offset: 44282},
+							expr: &seqExpr{
+								pos: position{line: 1149, col: 23, offset: 44283},
+								exprs: []any{
+									&notExpr{
+										pos: position{line: 1149, col: 23, offset: 44283},
+										expr: &litMatcher{
+											pos:        position{line: 1149, col: 24, offset: 44284},
+											val:        "*/",
+											ignoreCase: false,
+											want:       "\"*/\"",
+										},
+									},
+									&anyMatcher{
+										line: 1149, col: 29, offset: 44289,
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1149, col: 33, offset: 44293},
+							val:        "*/",
+							ignoreCase: false,
+							want:       "\"*/\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Include",
+			pos:  position{line: 1153, col: 1, offset: 44420},
+			expr: &actionExpr{
+				pos: position{line: 1153, col: 12, offset: 44431},
+				run: (*parser).callonInclude1,
+				expr: &seqExpr{
+					pos: position{line: 1153, col: 12, offset: 44431},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1153, col: 12, offset: 44431},
+							val:        "@",
+							ignoreCase: false,
+							want:       "\"@\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 1153, col: 16, offset: 44435},
+							label: "relative",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1153, col: 25, offset: 44444},
+								expr: &litMatcher{
+									pos:        position{line: 1153, col: 25, offset: 44444},
+									val:        "@",
+									ignoreCase: false,
+									want:       "\"@\"",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1153, col: 30, offset: 44449},
+							expr: &charClassMatcher{
+								pos:        position{line: 1153, col: 30, offset: 44449},
+								val:        "[ \\t]",
+								chars:      []rune{' ', '\t'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1153, col: 37, offset: 44456},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1153, col: 42, offset: 44461},
+								name: "IncludeWord",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1153, col: 54, offset: 44473},
+							label: "args",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1153, col: 59, offset: 44478},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1153, col: 59, offset: 44478},
+									name: "IncludeArg",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1153, col: 71, offset: 44490},
+							expr: &charClassMatcher{
+								pos:        position{line: 1153, col: 71, offset: 44490},
+								val:        "[ \\t]",
+								chars:      []rune{' ', '\t'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 1153, col: 79, offset: 44498},
+							alternatives: []any{
+								&seqExpr{
+									pos: position{line: 1153, col: 79, offset: 44498},
+									exprs: []any{
+										&zeroOrOneExpr{
+											pos: position{line: 1153, col: 79, offset: 44498},
+											expr: &litMatcher{
+												pos:        position{line: 1153, col: 79, offset: 44498},
+												val:        "\r",
+												ignoreCase: false,
+												want:       "\"\\r\"",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 1153, col: 85, offset: 44504},
+											val:        "\n",
+											ignoreCase: false,
+											want:       "\"\\n\"",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1153, col: 92, offset: 44511},
+									name: "EOF",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IncludeArg",
+			pos:  position{line: 1160, col: 1, offset: 44755},
+			expr: &actionExpr{
+				pos: position{line: 1160, col: 15, offset: 44769},
+				run: (*parser).callonIncludeArg1,
+				expr: &seqExpr{
+					pos: position{line: 1160, col: 15, offset: 44769},
+					exprs: []any{
+						&oneOrMoreExpr{
+							pos: position{line: 1160, col: 15, offset: 44769},
+							expr: &charClassMatcher{
+								pos:        position{line: 1160, col: 15, offset: 44769},
+								val:        "[ \\t]",
+								chars:      []rune{' ', '\t'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1160, col: 22, offset: 44776},
+							label: "arg",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1160, col: 26, offset: 44780},
+								name: "IncludeWord",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IncludeWord",
+			pos:  position{line: 1163, col: 1, offset: 44817},
+			expr: &choiceExpr{
+				pos: position{line: 1163, col: 16, offset: 44832},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 1163, col: 16, offset: 44832},
+						run: (*parser).callonIncludeWord2,
+						expr: &seqExpr{
+							pos: position{line: 1163, col: 16, offset: 44832},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 1163, col: 16, offset: 44832},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 1163, col: 20, offset: 44836},
+									expr: &charClassMatcher{
+										pos:        position{line: 1163, col: 20, offset: 44836},
+										val:        "[^\"\\r\\n]",
+										chars:      []rune{'"', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1163, col: 30, offset: 44846},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1165, col: 5, offset: 44906},
+						run: (*parser).callonIncludeWord8,
+						expr: &oneOrMoreExpr{
+							pos: position{line: 1165, col: 5, offset: 44906},
+							expr: &charClassMatcher{
+								pos:        position{line: 1165, col: 5, offset: 44906},
+								val:        "[^ \\t\\r\\n]",
+								chars:      []rune{' ', '\t', '\r', '\n'},
+								ignoreCase: false,
+								inverted:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 1169, col: 1, offset: 44956},
+			expr: &notExpr{
+				pos: position{line: 1169, col: 8, offset: 44963},
+				expr: &anyMatcher{
+					line: 1169, col: 9, offset: 44964,
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onFile1(stmts any) (any, error) {
+
+	var res []any
+	//for each pair in stmts
+	for _, stmt := range stmts.([]any) {
+		pair := stmt.([]any)
+		// pair[1] is WhiteSpace and we don't care about it
+		if pair[0] != nil {
+			res = append(res, pair[0])
+		}
+	}
+	return res, nil
+}
+
+func (p *parser) callonFile1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFile1(stack["stmts"])
+}
+
+func (c *current) onSqlPlusSlash1() (any, error) {
+
+	return nil, nil
+}
+
+func (p *parser) callonSqlPlusSlash1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSqlPlusSlash1()
+}
+
+func (c *current) onSqlPlusCommand1() (any, error) {
+
+	return nil, nil
+}
+
+func (p *parser) callonSqlPlusCommand1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSqlPlusCommand1()
+}
+
+func (c *current) onSkippedStatement3() (bool, error) {
+	return c.globalStore[TOLERANT_KEY] == true, nil
+}
+
+func (p *parser) callonSkippedStatement3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSkippedStatement3()
+}
+
+func (c *current) onSkippedStatement1() (any, error) {
+
+	return &generic.SkippedStatement{Text: strings.TrimSpace(string(c.text)), Line: c.pos.line, Column: c.pos.col}, nil
+}
+
+func (p *parser) callonSkippedStatement1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSkippedStatement1()
+}
+
+func (c *current) onCreateTable1(temp, name, body, physical any) (any, error) {
+
+	result := generic.TableDef{
+		Name:     name.(generic.QualifiedName),
+		Columns:  nil,
+		Position: sourcePosition(c),
+	}
+
+	switch b := body.(type) {
+	case generic.TableDef:
+		result.Columns = b.Columns
+		result.Constraints = b.Constraints
+		result.SelectStatement = b.SelectStatement
+		result.SelectColumns = b.SelectColumns
+		result.Select = b.Select
+		result.Physical = b.Physical
+	case string:
+		result.SelectStatement = b
+	}
+	if p := physical.(*generic.TablePhysicalDef); p != nil {
+		result.Physical = p
+	}
+	if temp != nil {
+		result.Temporary = temp.([]any)[0].(string)
+	}
+	if result.Physical != nil {
+		if o := result.Physical.Take("ON COMMIT"); o != nil {
+			result.OnCommit = o.Value
+		}
+		if result.Physical.IsEmpty() {
+			result.Physical = nil
+		}
+	}
+
+	return result, nil
+}
+
+func (p *parser) callonCreateTable1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCreateTable1(stack["temp"], stack["name"], stack["body"], stack["physical"])
+}
+
+func (c *current) onCreateIndex1(kind, name, table, first, rest, opts, unparsed any) (any, error) {
+
+	result := generic.IndexDef{
+		Position: sourcePosition(c),
+		Name:     name.(generic.QualifiedName),
+		Table:    table.(generic.QualifiedName),
+		Columns:  []*generic.IndexColumnDef{first.(*generic.IndexColumnDef)},
+		Unparsed: unparsed.(string),
+	}
+	if kind != nil {
+		switch string(kind.([]any)[0].([]uint8)) {
+		case "UNIQUE":
+			result.Unique = true
+		case "BITMAP":
+			result.Bitmap = true
+		}
+	}
+	for _, r := range rest.([]any) {
+		result.Columns = append(result.Columns, r.([]any)[3].(*generic.IndexColumnDef))
+	}
+	for _, opt := range opts.([]any) {
+		o := opt.([]any)[1].(generic.PhysicalOption)
+		if o.Name == "TABLESPACE" {
+			result.Tablespace = o.Value
+			continue
+		}
+		result.Options = append(result.Options, o)
+	}
+	return result, nil
+}
+
+func (p *parser) callonCreateIndex1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCreateIndex1(stack["kind"], stack["name"], stack["table"], stack["first"], stack["rest"], stack["opts"], stack["unparsed"])
+}
+
+func (c *current) onIndexElement1(elem, order any) (any, error) {
+
+	result := elem.(*generic.IndexColumnDef)
+	if order != nil {
+		result.Descending = string(order.([]any)[1].([]uint8)) == "DESC"
+	}
+	return result, nil
+}
+
+func (p *parser) callonIndexElement1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexElement1(stack["elem"], stack["order"])
+}
+
+func (c *current) onIndexElementBody2(name any) (any, error) {
+
+	return &generic.IndexColumnDef{Name: name.(string)}, nil
+}
+
+func (p *parser) callonIndexElementBody2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexElementBody2(stack["name"])
+}
+
+func (c *current) onIndexElementBody8(e any) (any, error) {
+
+	expr := e.(generic.Expression)
+	return &generic.IndexColumnDef{Expression: &expr}, nil
+}
+
+func (p *parser) callonIndexElementBody8() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexElementBody8(stack["e"])
+}
+
+func (c *current) onIndexElementBody14() (any, error) {
+
+	return &generic.IndexColumnDef{Expression: &generic.Expression{Text: strings.TrimSpace(string(c.text))}}, nil
+}
+
+func (p *parser) callonIndexElementBody14() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexElementBody14()
+}
+
+func (c *current) onIndexUnparsed1() (any, error) {
+
+	return strings.TrimSpace(string(c.text)), nil
+}
+
+func (p *parser) callonIndexUnparsed1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexUnparsed1()
+}
+
+func (c *current) onLocalIndexOption1(parts any) (any, error) {
+
+	result := generic.PhysicalOption{Name: "LOCAL"}
+	if parts != nil {
+		result.Value = strings.Join(strings.Fields(parts.([]any)[1].(string)), " ")
+	}
+	return result, nil
+}
+
+func (p *parser) callonLocalIndexOption1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLocalIndexOption1(stack["parts"])
+}
+
+func (c *current) onCreateSequence1(name, opts any) (any, error) {
+
+	result := generic.SequenceDef{
+		Name:     name.(generic.QualifiedName),
+		Position: sourcePosition(c),
+		Options:  sequenceOptions(opts),
+	}
+	return result, nil
+}
+
+func (p *parser) callonCreateSequence1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCreateSequence1(stack["name"], stack["opts"])
+}
+
+func (c *current) onSequenceValueOption1(name, val any) (any, error) {
+
+	return []string{name.(string), val.(string)}, nil
+}
+
+func (p *parser) callonSequenceValueOption1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSequenceValueOption1(stack["name"], stack["val"])
+}
+
+func (c *current) onSequenceValueKeyword1() (any, error) {
+
+	return strings.Join(strings.Fields(string(c.text)), " "), nil
+}
+
+func (p *parser) callonSequenceValueKeyword1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSequenceValueKeyword1()
+}
+
+func (c *current) onSequenceNumber1() (any, error) {
+
+	return string(c.text), nil
+}
+
+func (p *parser) callonSequenceNumber1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSequenceNumber1()
+}
+
+func (c *current) onSequenceFlag1() (any, error) {
+
+	return []string{strings.Join(strings.Fields(string(c.text)), " "), ""}, nil
+}
+
+func (p *parser) callonSequenceFlag1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSequenceFlag1()
+}
+
+func (c *current) onAlterTable1(name, items any) (any, error) {
+
+	result := generic.AlterTable{
+		Table:    name.(generic.QualifiedName),
+		Position: sourcePosition(c),
+	}
+	for _, item := range items.([]any) {
+		result.Actions = append(result.Actions, item.([]any)[1].([]*generic.AlterAction)...)
+	}
+	return result, nil
+}
+
+func (p *parser) callonAlterTable1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterTable1(stack["name"], stack["items"])
+}
+
+func (c *current) onAlterTableAction1(actions any) (any, error) {
+
+	for _, action := range actions.([]*generic.AlterAction) {
+		action.Position = sourcePosition(c)
+	}
+	return actions, nil
+}
+
+func (p *parser) callonAlterTableAction1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterTableAction1(stack["actions"])
+}
+
+func (c *current) onAlterAddConstraint1(con any) (any, error) {
+
+	return []*generic.AlterAction{{Kind: generic.ALTER_ADD_CONSTRAINT, Constraint: con.(*generic.ConstraintDef)}}, nil
+}
+
+func (p *parser) callonAlterAddConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterAddConstraint1(stack["con"])
+}
+
+func (c *current) onAlterAddList1(elems any) (any, error) {
+
+	results := []*generic.AlterAction{}
+	body := elems.(generic.TableDef)
+	for _, col := range body.Columns {
+		results = append(results, &generic.AlterAction{Kind: generic.ALTER_ADD_COLUMN, Column: col})
+	}
+	for _, con := range body.Constraints {
+		results = append(results, &generic.AlterAction{Kind: generic.ALTER_ADD_CONSTRAINT, Constraint: con})
+	}
+	return results, nil
+}
+
+func (p *parser) callonAlterAddList1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterAddList1(stack["elems"])
+}
+
+func (c *current) onAlterAddColumn1(col any) (any, error) {
+
+	return []*generic.AlterAction{{Kind: generic.ALTER_ADD_COLUMN, Column: col.(*generic.ColumnDef)}}, nil
+}
+
+func (p *parser) callonAlterAddColumn1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterAddColumn1(stack["col"])
+}
+
+func (c *current) onAlterModifyConstraint1(name, items any) (any, error) {
+
+	result := &generic.AlterAction{
+		Kind:       generic.ALTER_MODIFY_CONSTRAINT,
+		Constraint: &generic.ConstraintDef{Name: name.(string), Position: sourcePosition(c)},
+	}
+	for _, item := range items.([]any) {
+		result.State = append(result.State, item.([]any)[1].(string))
+	}
+	return []*generic.AlterAction{result}, nil
+}
+
+func (p *parser) callonAlterModifyConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterModifyConstraint1(stack["name"], stack["items"])
+}
+
+func (c *current) onAlterModifyList1(first, rest any) (any, error) {
+
+	results := []*generic.AlterAction{first.(*generic.AlterAction)}
+	for _, r := range rest.([]any) {
+		results = append(results, r.([]any)[3].(*generic.AlterAction))
+	}
+	return results, nil
+}
+
+func (p *parser) callonAlterModifyList1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterModifyList1(stack["first"], stack["rest"])
+}
+
+func (c *current) onAlterModifyColumn1(col any) (any, error) {
+
+	return []*generic.AlterAction{col.(*generic.AlterAction)}, nil
+}
+
+func (p *parser) callonAlterModifyColumn1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterModifyColumn1(stack["col"])
+}
+
+func (c *current) onModifyColumn1(colname, coltype, ident, defVal, cons any) (any, error) {
+
+	result := &generic.ColumnDef{
+		Name:     colname.(string),
+		Position: sourcePosition(c),
+	}
+	if coltype != nil {
+		result.Type = coltype.([]any)[1].(generic.DataType)
+	}
+	if ident != nil {
+		result.Identity = ident.([]any)[1].(*generic.IdentityDef)
+	}
+	if defVal != nil && defVal.([]any)[1] != nil {
+		result.Default = defVal.([]any)[1].(*generic.Expression)
+	}
+	if cons != nil {
+		result.Constraints = cons.([]any)[1].([]*generic.ConstraintDef)
+	}
+	return &generic.AlterAction{Kind: generic.ALTER_MODIFY_COLUMN, Column: result}, nil
+}
+
+func (p *parser) callonModifyColumn1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onModifyColumn1(stack["colname"], stack["coltype"], stack["ident"], stack["defVal"], stack["cons"])
+}
+
+func (c *current) onAlterDropConstraint1(target any) (any, error) {
+
+	return []*generic.AlterAction{{Kind: generic.ALTER_DROP_CONSTRAINT, Constraint: target.(*generic.ConstraintDef)}}, nil
+}
+
+func (p *parser) callonAlterDropConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlterDropConstraint1(stack["target"])
+}
+
+func (c *current) onDropNamedConstraint1(name any) (any, error) {
+
+	return &generic.ConstraintDef{Name: name.(string), Position: sourcePosition(c)}, nil
+}
+
+func (p *parser) callonDropNamedConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDropNamedConstraint1(stack["name"])
+}
+
+func (c *current) onDropPrimaryKey1() (any, error) {
+
+	return &generic.ConstraintDef{Kind: generic.CONSTRAINT_PRIMARY_KEY, Position: sourcePosition(c)}, nil
+}
+
+func (p *parser) callonDropPrimaryKey1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDropPrimaryKey1()
+}
+
+func (c *current) onGrant1(privs, where, who, opts any) (any, error) {
+
+	result := generic.Grant{
+		Position:   sourcePosition(c),
+		Privileges: privs.([]generic.Privilege),
+		Where:      where.(generic.QualifiedName),
+		Who:        who.([]generic.NamePart),
+	}
+	for _, opt := range opts.([]any) {
+		if string(opt.([]any)[3].([]uint8)) == "GRANT" {
+			result.WithGrantOption = true
+		}
+	}
+	return result, nil
+}
+
+func (p *parser) callonGrant1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGrant1(stack["privs"], stack["where"], stack["who"], stack["opts"])
+}
+
+func (c *current) onRevoke1(privs, where, who any) (any, error) {
+
+	result := generic.Grant{
+		Position:   sourcePosition(c),
+		Revoke:     true,
+		Privileges: privs.([]generic.Privilege),
+		Where:      where.(generic.QualifiedName),
+		Who:        who.([]generic.NamePart),
+	}
+	return result, nil
+}
+
+func (p *parser) callonRevoke1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRevoke1(stack["privs"], stack["where"], stack["who"])
+}
+
+func (c *current) onPrivilegeList1(first, rest any) (any, error) {
+
+	results := []generic.Privilege{first.(generic.Privilege)}
+	for _, r := range rest.([]any) {
+		results = append(results, r.([]any)[3].(generic.Privilege))
+	}
+	return results, nil
+}
+
+func (p *parser) callonPrivilegeList1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrivilegeList1(stack["first"], stack["rest"])
+}
+
+func (c *current) onPrivilege1(name, cols any) (any, error) {
+
+	result := generic.Privilege{Name: name.(string)}
+	if cols != nil {
+		result.Columns = cols.([]any)[1].([]string)
+	}
+	return result, nil
+}
+
+func (p *parser) callonPrivilege1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrivilege1(stack["name"], stack["cols"])
+}
+
+func (c *current) onPrivilegeName1() (any, error) {
+
+	name := strings.Join(strings.Fields(string(c.text)), " ")
+	if name == "ALL PRIVILEGES" {
+		name = "ALL"
+	}
+	return name, nil
+}
+
+func (p *parser) callonPrivilegeName1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrivilegeName1()
+}
+
+func (c *current) onGranteeList1(first, rest any) (any, error) {
+
+	results := []generic.NamePart{first.(generic.NamePart)}
+	for _, r := range rest.([]any) {
+		results = append(results, r.([]any)[3].(generic.NamePart))
+	}
+	return results, nil
+}
+
+func (p *parser) callonGranteeList1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGranteeList1(stack["first"], stack["rest"])
+}
+
+func (c *current) onComment1(kind, name, text any) (any, error) {
+
+	parts := name.([]generic.NamePart)
+	result := generic.Comment{
+		Position: sourcePosition(c),
+		On:       string(kind.([]uint8)),
+		Text:     text.(string),
+	}
+	// the last part of a column comment is the column
+	if result.On == generic.COMMENT_ON_COLUMN && len(parts) > 1 {
+		result.For = generic.NewQualifiedName(parts[:len(parts)-1]...)
+		result.For.Column = parts[len(parts)-1]
+	} else {
+		result.For = generic.NewQualifiedName(parts...)
+	}
+	return result, nil
+}
+
+func (p *parser) callonComment1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComment1(stack["kind"], stack["name"], stack["text"])
+}
+
+func (c *current) onTableName1(parts any) (any, error) {
+
+	return generic.NewQualifiedName(parts.([]generic.NamePart)...), nil
+}
+
+func (p *parser) callonTableName1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableName1(stack["parts"])
+}
+
+func (c *current) onNameParts1(first, rest any) (any, error) {
+
+	results := []generic.NamePart{first.(generic.NamePart)}
+	// rest is a slice of []any, each of which looks like []any{"." as []uint8, part}
+	for _, r := range rest.([]any) {
+		results = append(results, r.([]any)[1].(generic.NamePart))
+	}
+	return results, nil
+}
+
+func (p *parser) callonNameParts1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNameParts1(stack["first"], stack["rest"])
+}
+
+func (c *current) onNamePart2(name any) (any, error) {
+
+	return generic.NamePart{Name: name.(string), Quoted: true}, nil
+}
+
+func (p *parser) callonNamePart2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNamePart2(stack["name"])
+}
+
+func (c *current) onNamePart5() (any, error) {
+
+	return generic.NamePart{Name: string(c.text)}, nil
+}
+
+func (p *parser) callonNamePart5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNamePart5()
+}
+
+func (c *current) onTableNamePart3() (any, error) {
+
+	return string(c.text), nil
+}
+
+func (p *parser) callonTableNamePart3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableNamePart3()
+}
+
+func (c *current) onTableBodyDef1(elems any) (any, error) {
+
+	return elems, nil
+}
+
+func (p *parser) callonTableBodyDef1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableBodyDef1(stack["elems"])
+}
+
+func (c *current) onTableElements1(items any) (any, error) {
+
+	results := generic.TableDef{
+		Columns: generic.ColumnsDef{},
+	}
+
+	for _, item := range items.([]any) {
+		if item == nil {
+			continue
+		}
+
+		for _, subitem := range item.([]any) {
+			if subitem == nil {
+				continue
+			}
+
+			switch elem := subitem.(type) {
+			case *generic.ColumnDef:
+				results.Columns.Add(elem)
+			case *generic.ConstraintDef:
+				results.Constraints = append(results.Constraints, elem)
+			}
+		}
+
+	}
+
+	return results, nil
+}
+
+func (p *parser) callonTableElements1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableElements1(stack["items"])
+}
+
+func (c *current) onTableConstraint1(name, body, state any) (any, error) {
+
+	result := body.(*generic.ConstraintDef)
+	result.Position = sourcePosition(c)
+	if name != nil {
+		result.Name = name.(string)
+	}
+	if state != nil {
+		result.State = state.(generic.ConstraintState)
+	}
+	return result, nil
+}
+
+func (p *parser) callonTableConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableConstraint1(stack["name"], stack["body"], stack["state"])
+}
+
+func (c *current) onOutOfLinePrimaryKey1(cols any) (any, error) {
+
+	return &generic.ConstraintDef{
+		Kind:    generic.CONSTRAINT_PRIMARY_KEY,
+		Columns: cols.([]string),
+	}, nil
+}
+
+func (p *parser) callonOutOfLinePrimaryKey1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOutOfLinePrimaryKey1(stack["cols"])
+}
+
+func (c *current) onOutOfLineUnique1(cols any) (any, error) {
+
+	return &generic.ConstraintDef{
+		Kind:    generic.CONSTRAINT_UNIQUE,
+		Columns: cols.([]string),
+	}, nil
+}
+
+func (p *parser) callonOutOfLineUnique1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOutOfLineUnique1(stack["cols"])
+}
+
+func (c *current) onOutOfLineForeignKey1(cols, ref any) (any, error) {
+
+	result := ref.(*generic.ConstraintDef)
+	result.Columns = cols.([]string)
+	return result, nil
+}
+
+func (p *parser) callonOutOfLineForeignKey1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOutOfLineForeignKey1(stack["cols"], stack["ref"])
+}
+
+func (c *current) onColumn1(colname, coltype, ident, defVal, cons any) (any, error) {
+
+	result := &generic.ColumnDef{
+		Name:     colname.(string),
+		Type:     coltype.(generic.DataType),
+		Position: sourcePosition(c),
+	}
+
+	if defVal != nil {
+		result.Default = defVal.(*generic.Expression)
+	}
+
+	if ident != nil {
+		result.Identity = ident.(*generic.IdentityDef)
+	}
+
+	if cons != nil {
+		result.AddConstraints(cons.([]*generic.ConstraintDef)...)
+	}
+
+	return result, nil
+}
+
+func (p *parser) callonColumn1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onColumn1(stack["colname"], stack["coltype"], stack["ident"], stack["defVal"], stack["cons"])
+}
+
+func (c *current) onVirtualColumn1(colname, coltype, expr, cons any) (any, error) {
+
+	result := &generic.ColumnDef{
+		Name:     colname.(string),
+		Position: sourcePosition(c),
+	}
+	if coltype != nil {
+		result.Type = coltype.([]any)[1].(generic.DataType)
+	}
+	e := expr.(generic.Expression)
+	result.Virtual = &e
+	if cons != nil {
+		result.AddConstraints(cons.([]any)[1].([]*generic.ConstraintDef)...)
+	}
+	return result, nil
+}
+
+func (p *parser) callonVirtualColumn1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVirtualColumn1(stack["colname"], stack["coltype"], stack["expr"], stack["cons"])
+}
+
+func (c *current) onColumnIdentity1(kind, opts any) (any, error) {
+
+	result := &generic.IdentityDef{Kind: generic.IDENTITY_ALWAYS}
+	if kind != nil {
+		result.Kind = kind.([]any)[0].(string)
+	}
+	if opts != nil {
+		result.Options = opts.(generic.SequenceOptions)
+	}
+	return result, nil
+}
+
+func (p *parser) callonColumnIdentity1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onColumnIdentity1(stack["kind"], stack["opts"])
+}
+
+func (c *current) onIdentityKind1() (any, error) {
+
+	return strings.Join(strings.Fields(string(c.text)), " "), nil
+}
+
+func (p *parser) callonIdentityKind1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIdentityKind1()
+}
+
+func (c *current) onIdentityOptions2(opts any) (any, error) {
+
+	return sequenceOptions(opts), nil
+}
+
+func (p *parser) callonIdentityOptions2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIdentityOptions2(stack["opts"])
+}
+
+func (c *current) onIdentityOptions16(opts any) (any, error) {
+
+	return sequenceOptions(opts), nil
+}
+
+func (p *parser) callonIdentityOptions16() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIdentityOptions16(stack["opts"])
+}
+
+func (c *current) onColumnDefault1(val any) (any, error) {
+
+	if val == nil {
+		return nil, nil
+	}
+	e := val.(generic.Expression)
+	return &e, nil
+}
+
+func (p *parser) callonColumnDefault1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onColumnDefault1(stack["val"])
+}
+
+func (c *current) onColumnDefaultValue3() (any, error) {
+
+	return generic.Expression{Text: string(c.text)}, nil
+}
+
+func (p *parser) callonColumnDefaultValue3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onColumnDefaultValue3()
+}
+
+func (c *current) onColumnConstraints1(items any) (any, error) {
+
+	results := []*generic.ConstraintDef{}
+	for _, item := range items.([]any) {
+		results = append(results, item.([]any)[1].(*generic.ConstraintDef))
+	}
+	return results, nil
+}
+
+func (p *parser) callonColumnConstraints1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onColumnConstraints1(stack["items"])
+}
+
+func (c *current) onColumnConstraint1(name, body, state any) (any, error) {
+
+	result := body.(*generic.ConstraintDef)
+	result.Position = sourcePosition(c)
+	if name != nil {
+		result.Name = name.(string)
+	}
+	if state != nil {
+		result.State = state.(generic.ConstraintState)
+	}
+	return result, nil
+}
+
+func (p *parser) callonColumnConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onColumnConstraint1(stack["name"], stack["body"], stack["state"])
+}
+
+func (c *current) onConstraintName1(name any) (any, error) {
+
+	return name, nil
+}
+
+func (p *parser) callonConstraintName1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConstraintName1(stack["name"])
+}
+
+func (c *current) onNotNullConstraint1() (any, error) {
+
+	return &generic.ConstraintDef{Kind: generic.CONSTRAINT_NOT_NULL}, nil
+}
+
+func (p *parser) callonNotNullConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNotNullConstraint1()
+}
+
+func (c *current) onNullConstraint1() (any, error) {
+
+	return &generic.ConstraintDef{Kind: generic.CONSTRAINT_NULL}, nil
+}
+
+func (p *parser) callonNullConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNullConstraint1()
+}
+
+func (c *current) onPrimaryKeyConstraint1() (any, error) {
+
+	return &generic.ConstraintDef{Kind: generic.CONSTRAINT_PRIMARY_KEY}, nil
+}
+
+func (p *parser) callonPrimaryKeyConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimaryKeyConstraint1()
+}
+
+func (c *current) onUniqueConstraint1() (any, error) {
+
+	return &generic.ConstraintDef{Kind: generic.CONSTRAINT_UNIQUE}, nil
+}
+
+func (p *parser) callonUniqueConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUniqueConstraint1()
+}
+
+func (c *current) onCheckConstraint1(cond any) (any, error) {
+
+	e := cond.(generic.Expression)
+	return &generic.ConstraintDef{
+		Kind:  generic.CONSTRAINT_CHECK,
+		Check: &e,
+	}, nil
+}
+
+func (p *parser) callonCheckConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCheckConstraint1(stack["cond"])
+}
+
+func (c *current) onReferencesConstraint1(table, cols, rule any) (any, error) {
+
+	result := &generic.ConstraintDef{
+		Kind:     generic.CONSTRAINT_FOREIGN_KEY,
+		RefTable: table.(generic.QualifiedName),
+	}
+	if cols != nil {
+		result.RefColumns = cols.([]string)
+	}
+	if rule != nil {
+		result.DeleteRule = rule.(string)
+	}
+	return result, nil
+}
+
+func (p *parser) callonReferencesConstraint1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onReferencesConstraint1(stack["table"], stack["cols"], stack["rule"])
+}
+
+func (c *current) onDeleteRule1(rule any) (any, error) {
+
+	if _, ok := rule.([]uint8); ok {
+		return "CASCADE", nil
+	}
+	return "SET NULL", nil
+}
+
+func (p *parser) callonDeleteRule1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDeleteRule1(stack["rule"])
+}
+
+func (c *current) onConstraintState1(items any) (any, error) {
+
+	result := generic.ConstraintState{}
+	keywords := []string{}
+	for _, item := range items.([]any) {
+		switch v := item.([]any)[1].(type) {
+		case *generic.UsingIndexDef:
+			result.UsingIndex = v
+		case string:
+			keywords = append(keywords, v)
+		}
+	}
+	result.Apply(keywords...)
+	return result, nil
+}
+
+func (p *parser) callonConstraintState1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConstraintState1(stack["items"])
+}
+
+func (c *current) onConstraintStateItem1() (any, error) {
+
+	return strings.Join(strings.Fields(string(c.text)), " "), nil
+}
+
+func (p *parser) callonConstraintStateItem1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConstraintStateItem1()
+}
+
+func (c *current) onUsingIndex1(target, opts any) (any, error) {
+
+	result := &generic.UsingIndexDef{}
+	if target != nil {
+		*result = target.([]any)[1].(generic.UsingIndexDef)
+	}
+	for _, opt := range opts.([]any) {
+		o := opt.([]any)[1].(generic.PhysicalOption)
+		if o.Name == "TABLESPACE" {
+			result.Tablespace = o.Value
+			continue
+		}
+		result.Options = append(result.Options, o)
+	}
+	return result, nil
+}
+
+func (p *parser) callonUsingIndex1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUsingIndex1(stack["target"], stack["opts"])
+}
+
+func (c *current) onUsingIndexTarget2(stmt any) (any, error) {
+
+	return generic.UsingIndexDef{Statement: stmt.(string)}, nil
+}
+
+func (p *parser) callonUsingIndexTarget2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUsingIndexTarget2(stack["stmt"])
+}
+
+func (c *current) onUsingIndexTarget5(name any) (any, error) {
+
+	return generic.UsingIndexDef{Name: name.(generic.QualifiedName)}, nil
+}
+
+func (p *parser) callonUsingIndexTarget5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUsingIndexTarget5(stack["name"])
+}
+
+func (c *current) onTablespaceOption1(name any) (any, error) {
+
+	return generic.PhysicalOption{Name: "TABLESPACE", Value: name.(string)}, nil
+}
+
+func (p *parser) callonTablespaceOption1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTablespaceOption1(stack["name"])
+}
+
+func (c *current) onStorageOption1(val any) (any, error) {
+
+	return generic.PhysicalOption{Name: "STORAGE", Value: strings.Join(strings.Fields(val.(string)), " ")}, nil
+}
+
+func (p *parser) callonStorageOption1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStorageOption1(stack["val"])
+}
+
+func (c *current) onNumericOption1(name, val any) (any, error) {
+
+	return generic.PhysicalOption{Name: string(name.([]uint8)), Value: strconv.Itoa(val.(int))}, nil
+}
+
+func (p *parser) callonNumericOption1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumericOption1(stack["name"], stack["val"])
+}
+
+func (c *current) onFlagOption1() (any, error) {
+
+	return generic.PhysicalOption{Name: strings.Join(strings.Fields(string(c.text)), " ")}, nil
+}
+
+func (p *parser) callonFlagOption1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFlagOption1()
+}
+
+func (c *current) onColumnList1(first, rest any) (any, error) {
+
+	results := []string{first.(string)}
+	for _, r := range rest.([]any) {
+		results = append(results, r.([]any)[3].(string))
+	}
+	return results, nil
+}
+
+func (p *parser) callonColumnList1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onColumnList1(stack["first"], stack["rest"])
+}
+
+func (c *current) onParenText1(body any) (any, error) {
+
+	return strings.TrimSpace(string(body.([]uint8))), nil
+}
+
+func (p *parser) callonParenText1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParenText1(stack["body"])
+}
+
+func (c *current) onParenBody1() (any, error) {
+
+	return c.text, nil
+}
+
+func (p *parser) callonParenBody1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParenBody1()
+}
+
+func (c *current) onExpression2(e any) (any, error) {
+
+	return e, nil
+}
+
+func (p *parser) callonExpression2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpression2(stack["e"])
+}
+
+func (c *current) onExpression12(text any) (any, error) {
+
+	return generic.Expression{Text: text.(string)}, nil
+}
+
+func (p *parser) callonExpression12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpression12(stack["text"])
+}
+
+func (c *current) onExpressionTree1(e any) (any, error) {
+
+	return generic.Expression{Text: string(c.text), Tree: e.(generic.Expr)}, nil
+}
+
+func (p *parser) callonExpressionTree1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpressionTree1(stack["e"])
+}
+
+func (c *current) onExpr1(first, rest any) (any, error) {
+
+	return binaryChain(first, rest), nil
+}
+
+func (p *parser) callonExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpr1(stack["first"], stack["rest"])
+}
+
+func (c *current) onOrRest1(right any) (any, error) {
+
+	return &generic.BinaryExpr{Op: "OR", Right: right.(generic.Expr)}, nil
+}
+
+func (p *parser) callonOrRest1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrRest1(stack["right"])
+}
+
+func (c *current) onAndExpr1(first, rest any) (any, error) {
+
+	return binaryChain(first, rest), nil
+}
+
+func (p *parser) callonAndExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAndExpr1(stack["first"], stack["rest"])
+}
+
+func (c *current) onAndRest1(right any) (any, error) {
+
+	return &generic.BinaryExpr{Op: "AND", Right: right.(generic.Expr)}, nil
+}
+
+func (p *parser) callonAndRest1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAndRest1(stack["right"])
+}
+
+func (c *current) onNotExpr2(e any) (any, error) {
+
+	return &generic.UnaryExpr{Op: "NOT", Operand: e.(generic.Expr)}, nil
+}
+
+func (p *parser) callonNotExpr2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNotExpr2(stack["e"])
+}
+
+func (c *current) onPredicate1(left, tail any) (any, error) {
+
+	if tail == nil {
+		return left, nil
+	}
+	return predicate(left.(generic.Expr), tail.([]any)[1].(generic.Expr)), nil
+}
+
+func (p *parser) callonPredicate1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPredicate1(stack["left"], stack["tail"])
+}
+
+func (c *current) onIsNullTail1(not any) (any, error) {
+
+	return &generic.IsNullExpr{Not: not != nil}, nil
+}
+
+func (p *parser) callonIsNullTail1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIsNullTail1(stack["not"])
+}
+
+func (c *current) onInTail1(not, list any) (any, error) {
+
+	return &generic.InExpr{List: list.([]generic.Expr), Not: not != nil}, nil
+}
+
+func (p *parser) callonInTail1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInTail1(stack["not"], stack["list"])
+}
+
+func (c *current) onBetweenTail1(not, low, high any) (any, error) {
+
+	return &generic.BetweenExpr{Low: low.(generic.Expr), High: high.(generic.Expr), Not: not != nil}, nil
+}
+
+func (p *parser) callonBetweenTail1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBetweenTail1(stack["not"], stack["low"], stack["high"])
+}
+
+func (c *current) onLikeTail1(not, right any) (any, error) {
+
+	op := "LIKE"
+	if not != nil {
+		op = "NOT LIKE"
+	}
+	return &generic.BinaryExpr{Op: op, Right: right.(generic.Expr)}, nil
+}
+
+func (p *parser) callonLikeTail1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLikeTail1(stack["not"], stack["right"])
+}
+
+func (c *current) onCompareTail1(op, right any) (any, error) {
+
+	return &generic.BinaryExpr{Op: string(op.([]uint8)), Right: right.(generic.Expr)}, nil
+}
+
+func (p *parser) callonCompareTail1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCompareTail1(stack["op"], stack["right"])
+}
+
+func (c *current) onAddExpr1(first, rest any) (any, error) {
+
+	return binaryChain(first, rest), nil
+}
+
+func (p *parser) callonAddExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAddExpr1(stack["first"], stack["rest"])
+}
+
+func (c *current) onAddRest1(op, right any) (any, error) {
+
+	return &generic.BinaryExpr{Op: string(op.([]uint8)), Right: right.(generic.Expr)}, nil
+}
+
+func (p *parser) callonAddRest1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAddRest1(stack["op"], stack["right"])
+}
+
+func (c *current) onMulExpr1(first, rest any) (any, error) {
+
+	return binaryChain(first, rest), nil
+}
+
+func (p *parser) callonMulExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMulExpr1(stack["first"], stack["rest"])
+}
+
+func (c *current) onMulRest1(op, right any) (any, error) {
+
+	return &generic.BinaryExpr{Op: string(op.([]uint8)), Right: right.(generic.Expr)}, nil
+}
+
+func (p *parser) callonMulRest1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMulRest1(stack["op"], stack["right"])
+}
+
+func (c *current) onUnaryExpr2(op, e any) (any, error) {
+
+	return &generic.UnaryExpr{Op: string(op.([]uint8)), Operand: e.(generic.Expr)}, nil
+}
+
+func (p *parser) callonUnaryExpr2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnaryExpr2(stack["op"], stack["e"])
+}
+
+func (c *current) onParenExpr1(e any) (any, error) {
+
+	return &generic.ParenExpr{Inner: e.(generic.Expr)}, nil
+}
+
+func (p *parser) callonParenExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParenExpr1(stack["e"])
+}
+
+func (c *current) onCaseExpr1(operand, whens, els any) (any, error) {
+
+	result := &generic.CaseExpr{}
+	if operand != nil {
+		result.Operand = operand.(generic.Expr)
+	}
+	for _, when := range whens.([]any) {
+		result.Whens = append(result.Whens, when.(*generic.WhenExpr))
+	}
+	if els != nil {
+		result.Else = els.(generic.Expr)
+	}
+	return result, nil
+}
+
+func (p *parser) callonCaseExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCaseExpr1(stack["operand"], stack["whens"], stack["els"])
+}
+
+func (c *current) onCaseOperand1(e any) (any, error) {
+
+	return e, nil
+}
+
+func (p *parser) callonCaseOperand1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCaseOperand1(stack["e"])
+}
+
+func (c *current) onCaseWhen1(when, then any) (any, error) {
+
+	return &generic.WhenExpr{When: when.(generic.Expr), Then: then.(generic.Expr)}, nil
+}
+
+func (p *parser) callonCaseWhen1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCaseWhen1(stack["when"], stack["then"])
+}
+
+func (c *current) onCaseElse1(e any) (any, error) {
+
+	return e, nil
+}
+
+func (p *parser) callonCaseElse1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCaseElse1(stack["e"])
+}
+
+func (c *current) onLiteralExpr2(s any) (any, error) {
+
+	return &generic.LiteralExpr{Kind: generic.LITERAL_STRING, Value: s.(string)}, nil
+}
+
+func (p *parser) callonLiteralExpr2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLiteralExpr2(stack["s"])
+}
+
+func (c *current) onLiteralExpr5() (any, error) {
+
+	return &generic.LiteralExpr{Kind: generic.LITERAL_NUMBER, Value: string(c.text)}, nil
+}
+
+func (p *parser) callonLiteralExpr5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLiteralExpr5()
+}
+
+func (c *current) onLiteralExpr12() (any, error) {
+
+	return &generic.LiteralExpr{Kind: generic.LITERAL_NULL}, nil
+}
+
+func (p *parser) callonLiteralExpr12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLiteralExpr12()
+}
+
+func (c *current) onFunctionExpr2(name any) (any, error) {
+
+	return &generic.FunctionExpr{Name: generic.NewQualifiedName(name.([]generic.NamePart)...), Star: true}, nil
+}
+
+func (p *parser) callonFunctionExpr2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionExpr2(stack["name"])
+}
+
+func (c *current) onFunctionExpr15(name, args any) (any, error) {
+
+	result := &generic.FunctionExpr{Name: generic.NewQualifiedName(name.([]generic.NamePart)...)}
+	if args != nil {
+		result.Args = args.([]generic.Expr)
+	}
+	return result, nil
+}
+
+func (p *parser) callonFunctionExpr15() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionExpr15(stack["name"], stack["args"])
+}
+
+func (c *current) onExprList1(first, rest any) (any, error) {
+
+	results := []generic.Expr{first.(generic.Expr)}
+	for _, r := range rest.([]any) {
+		results = append(results, r.([]any)[3].(generic.Expr))
+	}
+	return results, nil
+}
+
+func (p *parser) callonExprList1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExprList1(stack["first"], stack["rest"])
+}
+
+func (c *current) onNameExpr1(parts any) (any, error) {
+
+	return &generic.NameExpr{Parts: parts.([]generic.NamePart)}, nil
+}
+
+func (p *parser) callonNameExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNameExpr1(stack["parts"])
+}
+
+func (c *current) onExprName1(first, rest any) (any, error) {
+
+	results := []generic.NamePart{first.(generic.NamePart)}
+	for _, r := range rest.([]any) {
+		results = append(results, r.([]any)[1].(generic.NamePart))
+	}
+	return results, nil
+}
+
+func (p *parser) callonExprName1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExprName1(stack["first"], stack["rest"])
+}
+
+func (c *current) onExprNamePart2(name any) (any, error) {
+
+	return generic.NamePart{Name: name.(string), Quoted: true}, nil
+}
+
+func (p *parser) callonExprNamePart2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExprNamePart2(stack["name"])
+}
+
+func (c *current) onExprNamePart5() (any, error) {
+
+	return generic.NamePart{Name: string(c.text)}, nil
+}
+
+func (p *parser) callonExprNamePart5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExprNamePart5()
+}
+
+func (c *current) onIntervalType2(p any) (any, error) {
+
+	result := generic.DataType{Name: "INTERVAL YEAR TO MONTH", Precision: 2}
+	if p != nil {
+		result.Precision = p.(int)
+	}
+	return result, nil
+}
+
+func (p *parser) callonIntervalType2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIntervalType2(stack["p"])
+}
+
+func (c *current) onIntervalType14(p, f any) (any, error) {
+
+	result := generic.DataType{Name: "INTERVAL DAY TO SECOND", Precision: 2, FractionalSeconds: 6}
+	if p != nil {
+		result.Precision = p.(int)
+	}
+	if f != nil {
+		result.FractionalSeconds = f.(int)
+	}
+	return result, nil
+}
+
+func (p *parser) callonIntervalType14() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIntervalType14(stack["p"], stack["f"])
+}
+
+func (c *current) onTimestampType1(f, tz any) (any, error) {
+
+	result := generic.DataType{Name: "TIMESTAMP", FractionalSeconds: 6}
+	if f != nil {
+		result.FractionalSeconds = f.(int)
+	}
+	if tz != nil {
+		result.TimeZone = tz.([]any)[3].(string)
+	}
+	return result, nil
+}
+
+func (p *parser) callonTimestampType1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTimestampType1(stack["f"], stack["tz"])
+}
+
+func (c *current) onTimeZoneKind1() (any, error) {
+
+	return strings.Join(strings.Fields(string(c.text)), " "), nil
+}
+
+func (p *parser) callonTimeZoneKind1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTimeZoneKind1()
+}
+
+func (c *current) onTypePrecision1(n any) (any, error) {
+
+	return n, nil
+}
+
+func (p *parser) callonTypePrecision1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTypePrecision1(stack["n"])
+}
+
+func (c *current) onBuiltinType1(name, args any) (any, error) {
+
+	result := generic.DataType{Name: name.(string)}
+	if args != nil {
+		applyTypeArgs(&result, args.([]any)[1])
+	}
+	return result, nil
+}
+
+func (p *parser) callonBuiltinType1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBuiltinType1(stack["name"], stack["args"])
+}
+
+func (c *current) onBuiltinTypeName1() (any, error) {
+
+	return strings.Join(strings.Fields(string(c.text)), " "), nil
+}
+
+func (p *parser) callonBuiltinTypeName1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBuiltinTypeName1()
+}
+
+func (c *current) onObjectType1(parts any) (any, error) {
+
+	name := generic.NewQualifiedName(parts.([]generic.NamePart)...)
+	return generic.DataType{Name: name.String(), UserDefined: name.Schema.Normalized() != "SYS"}, nil
+}
+
+func (p *parser) callonObjectType1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onObjectType1(stack["parts"])
+}
+
+func (c *current) onColumnTypeArgs1(args any) (any, error) {
+
+	results := []generic.ColumnTypeArg{}
+	for _, arg := range args.([]any) {
+		results = append(results, arg.(generic.ColumnTypeArg))
 	}
-	return res, nil
+	return results, nil
 }
 
-func (p *parser) callonFile1() (any, error) {
+func (p *parser) callonColumnTypeArgs1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFile1(stack["stmts"])
+	return p.cur.onColumnTypeArgs1(stack["args"])
 }
 
-func (c *current) onCreateTable1(name, body any) (any, error) {
+func (c *current) onColumnTypeArg1(num, numType any) (any, error) {
 
-	result := generic.TableDef{
-		Name:    name.(string),
-		Columns: nil,
+	result := generic.ColumnTypeArg{
+		Number: 0, //num.(int),
 	}
-
-	switch b := body.(type) {
-	case generic.ColumnsDef:
-		result.Columns = b
-	case string:
-		result.SelectStatement = b
+	switch num.(type) {
+	case int:
+		result.Number = num.(int)
+	}
+	if numType != nil {
+		result.Type = numType.(string)
 	}
-
 	return result, nil
 }
 
-func (p *parser) callonCreateTable1() (any, error) {
+func (p *parser) callonColumnTypeArg1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onCreateTable1(stack["name"], stack["body"])
+	return p.cur.onColumnTypeArg1(stack["num"], stack["numType"])
 }
 
-func (c *current) onGrant1(grantType, grantWhere, grantWho any) (any, error) {
+func (c *current) onNegativeDigits1(n any) (any, error) {
 
-	return generic.Grant{
-		Type:  grantType.(string),
-		Where: grantWhere.(string),
-		Who:   grantWho.(string),
-	}, nil
+	return -n.(int), nil
 }
 
-func (p *parser) callonGrant1() (any, error) {
+func (p *parser) callonNegativeDigits1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGrant1(stack["grantType"], stack["grantWhere"], stack["grantWho"])
+	return p.cur.onNegativeDigits1(stack["n"])
 }
 
-func (c *current) onGrantPublic1() (any, error) {
+func (c *current) onColumnTypeKeyword1() (any, error) {
 
 	return string(c.text), nil
 }
 
-func (p *parser) callonGrantPublic1() (any, error) {
+func (p *parser) callonColumnTypeKeyword1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGrantPublic1()
+	return p.cur.onColumnTypeKeyword1()
 }
 
-func (c *current) onGrantType1() (any, error) {
+func (c *current) onTablePhysical1(items any) (any, error) {
 
-	return string(c.text), nil
+	return tablePhysical(items, 1), nil
 }
 
-func (p *parser) callonGrantType1() (any, error) {
+func (p *parser) callonTablePhysical1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGrantType1()
+	return p.cur.onTablePhysical1(stack["items"])
 }
 
-func (c *current) onComment1(name, text any) (any, error) {
+func (c *current) onTemporaryKind1(kind any) (any, error) {
 
-	result := generic.Comment{
-		For:  name.(string),
-		Text: text.(string),
+	return string(kind.([]uint8)), nil
+}
+
+func (p *parser) callonTemporaryKind1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTemporaryKind1(stack["kind"])
+}
+
+func (c *current) onPartitioning1(kind, cols, interval, sub, count, store, parts any) (any, error) {
+
+	result := &generic.PartitioningDef{
+		Kind: string(kind.([]uint8)),
+	}
+	if cols != nil {
+		result.Columns = cols.([]any)[1].([]string)
+	}
+	if interval != nil {
+		result.Interval = interval.([]any)[3].(string)
+	}
+	if sub != nil {
+		result.Subpartitioning = sub.([]any)[1].(string)
+	}
+	if count != nil {
+		result.Count = count.([]any)[3].(int)
+	}
+	if store != nil {
+		result.StoreIn = store.([]any)[5].([]string)
+	}
+	if parts != nil {
+		result.Partitions = parts.([]any)[1].([]*generic.PartitionDef)
 	}
 	return result, nil
 }
 
-func (p *parser) callonComment1() (any, error) {
+func (p *parser) callonPartitioning1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onComment1(stack["name"], stack["text"])
+	return p.cur.onPartitioning1(stack["kind"], stack["cols"], stack["interval"], stack["sub"], stack["count"], stack["store"], stack["parts"])
+}
+
+func (c *current) onSubpartitioning1() (any, error) {
+
+	return strings.Join(strings.Fields(string(c.text)), " "), nil
 }
 
-func (c *current) onTableName1(first, rest any) (any, error) {
+func (p *parser) callonSubpartitioning1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSubpartitioning1()
+}
 
-	// Start with the first name part
-	name := first.(string)
+func (c *current) onPartitionList1(first, rest any) (any, error) {
 
-	// rest is a slice of []any, each of which looks like []any{"." as []uint8, "part" as string}
+	results := []*generic.PartitionDef{first.(*generic.PartitionDef)}
 	for _, r := range rest.([]any) {
-		parts := r.([]any)
-		dot := string(parts[0].([]uint8)) // the literal '.'
-		next := parts[1].(string)
-		name += dot + next
+		results = append(results, r.([]any)[3].(*generic.PartitionDef))
 	}
+	return results, nil
+}
 
-	return name, nil
+func (p *parser) callonPartitionList1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPartitionList1(stack["first"], stack["rest"])
 }
 
-func (p *parser) callonTableName1() (any, error) {
+func (c *current) onPartitionSpec1(name, values, attrs any) (any, error) {
+
+	result := &generic.PartitionDef{
+		Physical: tablePhysical(attrs, 1),
+	}
+	if name != nil {
+		result.Name = name.([]any)[2].(string)
+	}
+	if values != nil {
+		result.Values = splitList(values.([]any)[1].(string))
+	}
+	return result, nil
+}
+
+func (p *parser) callonPartitionSpec1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTableName1(stack["first"], stack["rest"])
+	return p.cur.onPartitionSpec1(stack["name"], stack["values"], stack["attrs"])
 }
 
-func (c *current) onTableNamePart3() (any, error) {
+func (c *current) onPartitionValues2(vals any) (any, error) {
 
-	return string(c.text), nil
+	return vals, nil
 }
 
-func (p *parser) callonTableNamePart3() (any, error) {
+func (p *parser) callonPartitionValues2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTableNamePart3()
+	return p.cur.onPartitionValues2(stack["vals"])
 }
 
-func (c *current) onTableBodyDef1(cols any) (any, error) {
+func (c *current) onPartitionValues13(vals any) (any, error) {
 
-	return cols, nil
+	return vals, nil
 }
 
-func (p *parser) callonTableBodyDef1() (any, error) {
+func (p *parser) callonPartitionValues13() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTableBodyDef1(stack["cols"])
+	return p.cur.onPartitionValues13(stack["vals"])
 }
 
-func (c *current) onColumns1(items any) (any, error) {
+func (c *current) onPartitionAttribute3() (any, error) {
 
-	results := generic.ColumnsDef{}
+	return unparsed(strings.Join(strings.Fields(string(c.text)), " ")), nil
+}
 
-	for _, item := range items.([]any) {
-		if item == nil {
-			continue
-		}
+func (p *parser) callonPartitionAttribute3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPartitionAttribute3()
+}
 
-		for _, subitem := range item.([]any) {
-			if subitem == nil {
-				continue
-			}
+func (c *current) onOnCommitOption1(action any) (any, error) {
 
-			switch column := subitem.(type) {
-			case *generic.ColumnDef:
-				results[column.Name] = column
-			}
-		}
+	return generic.PhysicalOption{Name: "ON COMMIT", Value: strings.Join(strings.Fields(string(c.text))[2:], " ")}, nil
+}
 
-	}
+func (p *parser) callonOnCommitOption1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOnCommitOption1(stack["action"])
+}
 
-	return results, nil
+func (c *current) onOrganizationOption1(kind any) (any, error) {
+
+	return generic.PhysicalOption{Name: "ORGANIZATION", Value: string(kind.([]uint8))}, nil
 }
 
-func (p *parser) callonColumns1() (any, error) {
+func (p *parser) callonOrganizationOption1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onColumns1(stack["items"])
+	return p.cur.onOrganizationOption1(stack["kind"])
 }
 
-func (c *current) onColumn1(colname, coltype, _c, defVal any) (any, error) {
+func (c *current) onIndexOrganizedOption2(val any) (any, error) {
 
-	coltypestr := coltype.(string)
+	return generic.PhysicalOption{Name: "PCTTHRESHOLD", Value: strconv.Itoa(val.(int))}, nil
+}
 
-	defValStr := ""
-	if defVal != nil {
-		defValStr = defVal.(string)
-	}
+func (p *parser) callonIndexOrganizedOption2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexOrganizedOption2(stack["val"])
+}
 
-	result := &generic.ColumnDef{
-		Name:    colname.(string),
-		Type:    coltypestr,
-		Default: defValStr,
+func (c *current) onIndexOrganizedOption8(opts any) (any, error) {
+
+	values := []string{}
+	for _, opt := range opts.([]any) {
+		values = append(values, opt.([]any)[1].(generic.PhysicalOption).String())
 	}
+	return generic.PhysicalOption{Name: "OVERFLOW", Value: strings.Join(values, " ")}, nil
+}
+
+func (p *parser) callonIndexOrganizedOption8() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexOrganizedOption8(stack["opts"])
+}
+
+func (c *current) onTableCompression1(level any) (any, error) {
 
-	if _c == nil {
-		return result, nil
+	result := generic.PhysicalOption{Name: "COMPRESS"}
+	if level != nil {
+		result.Value = level.([]any)[1].(string)
 	}
+	return result, nil
+}
 
-	items := _c.([]generic.ColumnTypeArg)
-	itemslen := len(items)
+func (p *parser) callonTableCompression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCompression1(stack["level"])
+}
 
-	if itemslen > 0 {
-		switch coltypestr {
-		case "NUMBER", "NUMERICAL", "DECIMAL":
-			result.Precision = items[0].Number
-			if itemslen > 1 {
-				result.Scale = items[1].Number
-			}
-		case "VARCHAR", "VARCHAR2":
-			result.VarCharSize = items[0].Number
+func (c *current) onCompressionLevel1() (any, error) {
 
+	return strings.Join(strings.Fields(string(c.text)), " "), nil
+}
+
+func (p *parser) callonCompressionLevel1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCompressionLevel1()
+}
+
+func (c *current) onTableFlagOption1() (any, error) {
+
+	return generic.PhysicalOption{Name: strings.Join(strings.Fields(string(c.text)), " ")}, nil
+}
+
+func (p *parser) callonTableFlagOption1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableFlagOption1()
+}
+
+func (c *current) onLobStorage1(cols, kind, seg, params any) (any, error) {
+
+	result := &generic.LobStorageDef{
+		Columns: cols.([]string),
+	}
+	if kind != nil {
+		result.Kind = string(kind.([]any)[1].([]uint8))
+	}
+	if seg != nil {
+		result.Segment = seg.([]any)[1].(string)
+	}
+	if params != nil {
+		rest := []string{}
+		for _, param := range params.([]any)[1].([]any) {
+			switch v := param.([]any)[1].(type) {
+			case generic.PhysicalOption:
+				if v.Name == "TABLESPACE" {
+					result.Tablespace = v.Value
+					continue
+				}
+				rest = append(rest, v.String())
+			case unparsed:
+				rest = append(rest, string(v))
+			}
 		}
+		result.Parameters = strings.Join(rest, " ")
 	}
-
 	return result, nil
 }
 
-func (p *parser) callonColumn1() (any, error) {
+func (p *parser) callonLobStorage1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onColumn1(stack["colname"], stack["coltype"], stack["_c"], stack["defVal"])
+	return p.cur.onLobStorage1(stack["cols"], stack["kind"], stack["seg"], stack["params"])
 }
 
-func (c *current) onColumnDefault1(val any) (any, error) {
+func (c *current) onLobParameters1(items any) (any, error) {
 
-	switch val.(type) {
-	case []uint8:
-		return string(val.([]uint8)), nil
-	case string:
-		return val.(string), nil
-	default:
-		return nil, nil
-	}
-	//return val.(string), nil
+	return items, nil
 }
 
-func (p *parser) callonColumnDefault1() (any, error) {
+func (p *parser) callonLobParameters1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onColumnDefault1(stack["val"])
+	return p.cur.onLobParameters1(stack["items"])
 }
 
-func (c *current) onColumnType1() (any, error) {
+func (c *current) onUnparsedToken1() (any, error) {
 
-	return string(c.text), nil
+	return unparsed(strings.Join(strings.Fields(string(c.text)), " ")), nil
 }
 
-func (p *parser) callonColumnType1() (any, error) {
+func (p *parser) callonUnparsedToken1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onColumnType1()
+	return p.cur.onUnparsedToken1()
 }
 
-func (c *current) onColumnTypeArgs1(args any) (any, error) {
+func (c *current) onTableBodySelect1(cols, physical, query any) (any, error) {
 
-	results := []generic.ColumnTypeArg{}
-	for _, arg := range args.([]any) {
-		results = append(results, arg.(generic.ColumnTypeArg))
+	result := query.(generic.TableDef)
+	result.Physical = tablePhysical(physical, 0)
+	if cols != nil {
+		result.SelectColumns = cols.([]any)[0].([]string)
 	}
-	return results, nil
+	return result, nil
 }
 
-func (p *parser) callonColumnTypeArgs1() (any, error) {
+func (p *parser) callonTableBodySelect1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onColumnTypeArgs1(stack["args"])
+	return p.cur.onTableBodySelect1(stack["cols"], stack["physical"], stack["query"])
 }
 
-func (c *current) onColumnTypeArg1(num, numType any) (any, error) {
+func (c *current) onSelectParsed1(q any) (any, error) {
 
-	result := generic.ColumnTypeArg{
-		Number: 0, //num.(int),
+	return generic.TableDef{SelectStatement: strings.TrimSpace(string(c.text)), Select: q.(*generic.SelectQuery)}, nil
+}
+
+func (p *parser) callonSelectParsed1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectParsed1(stack["q"])
+}
+
+func (c *current) onSelectText1(q any) (any, error) {
+
+	return generic.TableDef{SelectStatement: q.(string)}, nil
+}
+
+func (p *parser) callonSelectText1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectText1(stack["q"])
+}
+
+func (c *current) onSelectQuery1(distinct, items, from, where, rest any) (any, error) {
+
+	result := &generic.SelectQuery{
+		Distinct: distinct != nil,
+		Items:    items.([]*generic.SelectItem),
+		From:     from.(string),
+		Rest:     rest.(string),
 	}
-	switch num.(type) {
-	case int:
-		result.Number = num.(int)
+	if where != nil {
+		e := where.(generic.Expression)
+		result.Where = &e
 	}
-	if numType != nil {
-		result.Type = numType.(string)
+	return result, nil
+}
+
+func (p *parser) callonSelectQuery1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectQuery1(stack["distinct"], stack["items"], stack["from"], stack["where"], stack["rest"])
+}
+
+func (c *current) onSelectItems1(first, rest any) (any, error) {
+
+	results := []*generic.SelectItem{first.(*generic.SelectItem)}
+	for _, r := range rest.([]any) {
+		results = append(results, r.([]any)[3].(*generic.SelectItem))
+	}
+	return results, nil
+}
+
+func (p *parser) callonSelectItems1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectItems1(stack["first"], stack["rest"])
+}
+
+func (c *current) onSelectItem2() (any, error) {
+
+	return &generic.SelectItem{Star: string(c.text)}, nil
+}
+
+func (p *parser) callonSelectItem2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectItem2()
+}
+
+func (c *current) onSelectItem9(e, alias any) (any, error) {
+
+	expr := e.(generic.Expression)
+	result := &generic.SelectItem{Expression: &expr}
+	if alias != nil {
+		result.Alias = alias.(generic.NamePart)
 	}
 	return result, nil
 }
 
-func (p *parser) callonColumnTypeArg1() (any, error) {
+func (p *parser) callonSelectItem9() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onColumnTypeArg1(stack["num"], stack["numType"])
+	return p.cur.onSelectItem9(stack["e"], stack["alias"])
 }
 
-func (c *current) onColumnTypeKeyword1() (any, error) {
+func (c *current) onSelectAlias1(alias any) (any, error) {
 
-	return string(c.text), nil
+	return alias, nil
 }
 
-func (p *parser) callonColumnTypeKeyword1() (any, error) {
+func (p *parser) callonSelectAlias1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onColumnTypeKeyword1()
+	return p.cur.onSelectAlias1(stack["alias"])
+}
+
+func (c *current) onSelectFrom1() (any, error) {
+
+	return strings.TrimSpace(string(c.text)), nil
+}
+
+func (p *parser) callonSelectFrom1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectFrom1()
+}
+
+func (c *current) onSelectWhere1(e any) (any, error) {
+
+	return e, nil
+}
+
+func (p *parser) callonSelectWhere1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectWhere1(stack["e"])
+}
+
+func (c *current) onSelectRest1() (any, error) {
+
+	return strings.TrimRight(string(c.text), " \t\r\n"), nil
+}
+
+func (p *parser) callonSelectRest1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectRest1()
+}
+
+func (c *current) onSelectStatement1() (any, error) {
+
+	return strings.TrimSpace(string(c.text)), nil
+}
+
+func (p *parser) callonSelectStatement1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectStatement1()
 }
 
 func (c *current) onLiteralNumber1() (any, error) {
@@ -2517,15 +13651,52 @@ func (p *parser) callonBlockComment1() (any, error) {
 	return p.cur.onBlockComment1()
 }
 
-func (c *current) onInclude1() (any, error) {
+func (c *current) onInclude1(relative, path, args any) (any, error) {
 
-	return "", nil
+	result := &generic.Include{Path: path.(string), Relative: relative != nil, Line: c.pos.line, Column: c.pos.col}
+	for _, arg := range args.([]any) {
+		result.Args = append(result.Args, arg.(string))
+	}
+	return result, nil
 }
 
 func (p *parser) callonInclude1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInclude1()
+	return p.cur.onInclude1(stack["relative"], stack["path"], stack["args"])
+}
+
+func (c *current) onIncludeArg1(arg any) (any, error) {
+
+	return arg, nil
+}
+
+func (p *parser) callonIncludeArg1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIncludeArg1(stack["arg"])
+}
+
+func (c *current) onIncludeWord2() (any, error) {
+
+	return strings.Trim(string(c.text), `"`), nil
+}
+
+func (p *parser) callonIncludeWord2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIncludeWord2()
+}
+
+func (c *current) onIncludeWord8() (any, error) {
+
+	return string(c.text), nil
+}
+
+func (p *parser) callonIncludeWord8() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIncludeWord8()
 }
 
 var (
@@ -0,0 +1,79 @@
+package oracle
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+// global store key that lets SkippedStatement match, set by ParseTolerant
+const TOLERANT_KEY string = "tolerant"
+
+const knownStatements string = `(CREATE\s+((GLOBAL|PRIVATE)\s+TEMPORARY\s+)?TABLE|CREATE\s+((UNIQUE|BITMAP)\s+)?INDEX|CREATE\s+SEQUENCE|ALTER\s+TABLE|GRANT|REVOKE|COMMENT\s+ON)\b`
+
+// statements the grammar knows, when one of them is skipped it was malformed rather than unsupported
+var knownStatement = regexp.MustCompile(`(?i)^` + knownStatements)
+
+// a known statement starting a line inside skipped text, it was swallowed by a statement missing its ; or / line
+var knownStatementLine = regexp.MustCompile(`(?im)^[ \t]*` + knownStatements)
+
+/* Parses source like Parse but steps over statements the grammar can't read instead of failing
+ * parsing resyncs at the next ; or / line, PL/SQL blocks are skipped up to their / line
+ * skipped statements stay in the result as *generic.SkippedStatement and are reported as diagnostics,
+ * statements of a kind the grammar knows are errors and anything else is a warning
+ * pigeon's Recover option only turns panics into errors, so tolerance is switched on through the global store
+ */
+func ParseTolerant(filename string, source []byte, opts ...Option) ([]any, generic.Diagnostics) {
+	opts = append(opts, GlobalStore(TOLERANT_KEY, true))
+	res, err := Parse(filename, source, opts...)
+	if err != nil {
+		return nil, Diagnostics(filename, source, err)
+	}
+	stmts, _ := res.([]any)
+	results := generic.Diagnostics{}
+	for _, stmt := range stmts {
+		if skipped, ok := stmt.(*generic.SkippedStatement); ok {
+			results = append(results, skippedDiagnostics(filename, source, skipped)...)
+		}
+	}
+	return stmts, results
+}
+
+/* Explains why a statement was skipped
+ * a statement of a known kind is parsed again on its own to find where it goes wrong
+ * an unsupported statement that runs into a known one lost it, so that is an error at where the known one starts
+ */
+func skippedDiagnostics(filename string, source []byte, skipped *generic.SkippedStatement) generic.Diagnostics {
+	if !knownStatement.MatchString(skipped.Text) {
+		if loc := knownStatementLine.FindStringIndex(skipped.Text); loc != nil {
+			line := skipped.Line + strings.Count(skipped.Text[:loc[0]], "\n")
+			start := strings.TrimLeft(skipped.Text[loc[0]:loc[1]], " \t")
+			column := len(skipped.Text[loc[0]:loc[1]]) - len(start) + 1
+			message := fmt.Sprintf("%s was skipped with the unsupported statement on line %d, that statement is probably missing its ; or / line", strings.ToUpper(strings.Join(strings.Fields(start), " ")), skipped.Line)
+			return generic.Diagnostics{generic.NewDiagnostic(generic.SEVERITY_ERROR, filename, source, line, column, message)}
+		}
+		message := "skipped unsupported statement"
+		return generic.Diagnostics{generic.NewDiagnostic(generic.SEVERITY_WARNING, filename, source, skipped.Line, skipped.Column, message)}
+	}
+
+	_, err := Parse(filename, []byte(skipped.Text), Entrypoint("KnownStatement"))
+	if err == nil {
+		// the statement parses on its own, so it was its surroundings that went wrong
+		message := "skipped statement that couldn't be read in place"
+		return generic.Diagnostics{generic.NewDiagnostic(generic.SEVERITY_ERROR, filename, source, skipped.Line, skipped.Column, message)}
+	}
+	results := Diagnostics(filename, []byte(skipped.Text), err)
+	for _, d := range results {
+		// positions are relative to the statement, move them to where it is in the file
+		if d.Line == 1 {
+			d.Column += skipped.Column - 1
+		}
+		if d.Line > 0 {
+			d.Line += skipped.Line - 1
+			d.Snippet = generic.SourceLine(source, d.Line)
+		}
+		d.Message += ", the statement was skipped"
+	}
+	return results
+}
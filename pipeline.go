@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"tsqlgrl/generic"
+	"tsqlgrl/oracle"
+	"tsqlgrl/tsql"
+)
+
+/* A file to handle, Rel is its slash separated path below the directory it was found in */
+type Job struct {
+	File string
+	Rel  string
+}
+
+/* A file to write, Rel is its slash separated path inside OutDir */
+type Output struct {
+	Rel     string
+	Content string
+}
+
+/* What handling a file produced
+ * workers only fill results, MergeResult adds them up in job order so output and counts don't depend on scheduling
+ */
+type FileResult struct {
+	File        string
+	Diagnostics generic.Diagnostics
+	// statements by kind, see statementKind
+	Statements map[string]int
+	Tables     int
+	Outputs    []*Output
+	Parsed     *ParsedFile
+	// lines logged at VERBOSITY_DEBUG
+	Log    []string
+	Failed bool
+	// absolute paths of the scripts read through @ and @@
+	Included []string
+}
+
+func NewFileResult(fpath string) *FileResult {
+	return &FileResult{File: fpath, Statements: map[string]int{}}
+}
+
+/* Marks the file as failed and reports err */
+func (r *FileResult) fail(err error) {
+	r.Failed = true
+	r.Diagnostics = append(r.Diagnostics, generic.ErrorDiagnostics(generic.SEVERITY_ERROR, r.File, err)...)
+}
+
+/* Quotes the source line of diagnostics made after parsing, like those of converting, and keeps which scripts were included */
+func (r *FileResult) readFrom(reader *oracle.Script) {
+	for file, source := range reader.Sources {
+		r.Diagnostics.Snippets(file, source)
+		if file == r.File {
+			continue
+		}
+		if abs, err := filepath.Abs(file); err == nil && !slices.Contains(r.Included, abs) {
+			r.Included = append(r.Included, abs)
+		}
+	}
+	slices.Sort(r.Included)
+}
+
+func (r *FileResult) debug(line string) {
+	if Verbosity >= VERBOSITY_DEBUG {
+		r.Log = append(r.Log, line)
+	}
+}
+
+/* Keeps a converted script or parsed json, only convert and parse write anything */
+func (r *FileResult) output(rel string, content string) {
+	if Command == COMMAND_CONVERT || Command == COMMAND_PARSE {
+		r.Outputs = append(r.Outputs, &Output{Rel: rel, Content: content})
+	}
+}
+
+/* Counts the converted tables and keeps the serializer's diagnostics, those without a file are about the converted one */
+func (r *FileResult) converted(serializer *tsql.Serializer, tables *generic.TablesDef) {
+	r.Tables += len(tables.Tables)
+	for _, d := range serializer.Diagnostics {
+		if d.File == "" {
+			d.File = r.File
+		}
+		r.Diagnostics = append(r.Diagnostics, d)
+	}
+}
+
+/* Lists the files to handle in the order of paths, directories are walked in lexical order
+ * files given by name are always handled, files found in a directory have to match the include and exclude globs
+ * paths that can't be read are reported and left out
+ */
+func CollectJobs(paths []string) ([]*Job, generic.Diagnostics) {
+	jobs := []*Job{}
+	diagnostics := generic.Diagnostics{}
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			diagnostics = append(diagnostics, generic.ErrorDiagnostics(generic.SEVERITY_ERROR, p, err)...)
+			continue
+		}
+		if !fi.IsDir() {
+			jobs = append(jobs, &Job{File: p, Rel: filepath.Base(p)})
+			continue
+		}
+
+		filepath.WalkDir(p, func(filePath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				diagnostics = append(diagnostics, generic.ErrorDiagnostics(generic.SEVERITY_ERROR, filePath, err)...)
+				return nil
+			}
+			if d.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(p, filePath)
+			if err != nil || !Selected(filepath.ToSlash(rel), Includes, Excludes) {
+				return nil
+			}
+			jobs = append(jobs, &Job{File: filePath, Rel: filepath.ToSlash(rel)})
+			return nil
+		})
+	}
+	return jobs, diagnostics
+}
+
+/* Leaves out the results of scripts another result included, their statements were converted there already
+ * of scripts including each other, directly or not, the first one in job order is kept
+ * what was left out is returned as notes
+ */
+func DropIncluded(results []*FileResult) ([]*FileResult, generic.Diagnostics) {
+	abs := make([]string, len(results))
+	for i, r := range results {
+		abs[i], _ = filepath.Abs(r.File)
+	}
+	kept := []*FileResult{}
+	notes := generic.Diagnostics{}
+	for i, r := range results {
+		by := -1
+		for j, other := range results {
+			if j == i || !slices.Contains(other.Included, abs[i]) {
+				continue
+			}
+			// when r includes other as well they form a cycle and the earlier one stays
+			if !slices.Contains(r.Included, abs[j]) || j < i {
+				by = j
+				break
+			}
+		}
+		if by < 0 {
+			kept = append(kept, r)
+			continue
+		}
+		message := fmt.Sprintf("included by %s and only converted there", results[by].File)
+		notes = append(notes, &generic.Diagnostic{Severity: generic.SEVERITY_NOTE, File: r.File, Message: message})
+	}
+	return kept, notes
+}
+
+/* Handles the jobs on a pool of workers and merges each result in job order as soon as it and all before it are done */
+func RunJobs(jobs []*Job, workers int, merge func(*FileResult)) {
+	// one buffered channel per job lets workers finish out of order without blocking
+	results := make([]chan *FileResult, len(jobs))
+	for i := range results {
+		results[i] = make(chan *FileResult, 1)
+	}
+	next := make(chan int)
+	go func() {
+		for i := range jobs {
+			next <- i
+		}
+		close(next)
+	}()
+	for range min(workers, len(jobs)) {
+		go func() {
+			for i := range next {
+				results[i] <- HandleFile(jobs[i])
+			}
+		}()
+	}
+
+	for _, result := range results {
+		merge(<-result)
+	}
+}
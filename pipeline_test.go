@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+/* Writes scripts of very different sizes so workers finish them out of order */
+func writeJobs(t *testing.T) []*Job {
+	t.Helper()
+	dir := t.TempDir()
+	sizes := []int{40, 1, 0, 10, 1, 25, 2, 1, 0, 15, 1, 1}
+	jobs := []*Job{}
+	for i, size := range sizes {
+		var sb strings.Builder
+		for j := range size {
+			fmt.Fprintf(&sb, "CREATE TABLE \"T%d_%d\" (\"ID\" NUMBER(10) PRIMARY KEY, \"NAME\" VARCHAR2(30 CHAR));\n", i, j)
+		}
+		if size == 0 {
+			// a script that fails to parse has to keep its place too
+			sb.WriteString("CREATE TABLE (;\n")
+		}
+		rel := fmt.Sprintf("f%02d.sql", i)
+		fpath := filepath.Join(dir, rel)
+		if err := os.WriteFile(fpath, []byte(sb.String()), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		jobs = append(jobs, &Job{File: fpath, Rel: rel})
+	}
+	return jobs
+}
+
+/* What a run merged, in merge order */
+func runJobs(jobs []*Job, workers int) []string {
+	results := []string{}
+	RunJobs(jobs, workers, func(r *FileResult) {
+		outputs := []string{}
+		for _, output := range r.Outputs {
+			outputs = append(outputs, output.Rel+"\n"+output.Content)
+		}
+		results = append(results, fmt.Sprintf("%s failed=%v tables=%d diagnostics=%d\n%s", r.File, r.Failed, r.Tables, len(r.Diagnostics), strings.Join(outputs, "\n")))
+	})
+	return results
+}
+
+func TestRunJobs(t *testing.T) {
+	Command = COMMAND_CONVERT
+	t.Cleanup(func() { Command = "" })
+	jobs := writeJobs(t)
+	want := runJobs(jobs, 1)
+	if len(want) != len(jobs) {
+		t.Fatalf("got %d results for %d jobs", len(want), len(jobs))
+	}
+	for i, result := range want {
+		if !strings.HasPrefix(result, jobs[i].File+" ") {
+			t.Fatalf("result %d is for %s, want %s", i, strings.SplitN(result, " ", 2)[0], jobs[i].File)
+		}
+	}
+	tests := []struct {
+		workers int
+	}{
+		{2}, {4}, {len(jobs)}, {2 * len(jobs)},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d workers", test.workers), func(t *testing.T) {
+			// a few rounds, a lucky schedule could hide a misordering
+			for range 3 {
+				if got := runJobs(jobs, test.workers); !slices.Equal(got, want) {
+					t.Fatalf("merged results differ from a single worker run")
+				}
+			}
+		})
+	}
+}
+
+func TestRunJobsEmpty(t *testing.T) {
+	RunJobs(nil, 4, func(r *FileResult) {
+		t.Errorf("merged %s without jobs", r.File)
+	})
+}
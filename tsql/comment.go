@@ -0,0 +1,45 @@
+package tsql
+
+import (
+	"fmt"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* Quotes a unicode string literal, single quotes are escaped by doubling them */
+func QuoteString(str string) string {
+	return "N'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
+
+func (s *Serializer) description(text string, levels ...string) string {
+	args := []string{"@name = N'MS_Description'", "@value = " + QuoteString(text)}
+	for i := 0; i+1 < len(levels); i += 2 {
+		n := i / 2
+		args = append(args,
+			fmt.Sprintf("@level%dtype = N'%s'", n, levels[i]),
+			fmt.Sprintf("@level%dname = %s", n, QuoteString(levels[i+1])),
+		)
+	}
+	return "EXEC sys.sp_addextendedproperty " + strings.Join(args, ", ") + ";"
+}
+
+/* Converts table and column comments to MS_Description extended properties
+ * #temporary tables can't have extended properties, their comments are left out
+ */
+func (s *Serializer) Comments(t *generic.TableDef) []string {
+	results := []string{}
+	if strings.HasPrefix(t.Name.Object.Name, "#") {
+		return results
+	}
+	schema := s.Schema(t.Name)
+	table := t.Name.Object.Normalized()
+	if t.Comment != "" {
+		results = append(results, s.description(t.Comment, "SCHEMA", schema, "TABLE", table))
+	}
+	for _, c := range t.Columns {
+		if c.Comment != "" {
+			results = append(results, s.description(c.Comment, "SCHEMA", schema, "TABLE", table, "COLUMN", c.Name))
+		}
+	}
+	return results
+}
@@ -0,0 +1,670 @@
+package tsql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* Translates oracle expression trees to t-sql
+ * deterministic turns false once anything is used that sql server can't persist or index
+ */
+type translator struct {
+	s *Serializer
+	// table the expression's columns belong to, nil when they come from elsewhere like in a query
+	table         *generic.TableDef
+	subject       string
+	extras        *tableExtras
+	deterministic bool
+	// set when a node has no translation, the expression is then passed through as written
+	unknown string
+	// set for computed columns, they can't reference other computed columns so those are replaced by their expressions
+	// holds the names of the columns being inlined to stop at columns computed from each other
+	inlining []string
+	// writes string literals as N'...', set for values of NVARCHAR and NCHAR columns
+	national bool
+}
+
+/* Converts an expression to t-sql, the second result tells if the outcome is deterministic
+ * expressions the parser couldn't read are passed through as written and reported
+ */
+func (s *Serializer) Expression(t *generic.TableDef, e *generic.Expression, subject string, extras *tableExtras) (string, bool) {
+	return s.translator(t, subject, extras).translate(e)
+}
+
+/* Expression of a computed column, references to other computed columns of t are replaced by their expressions
+ * column is the computed column's name, empty for an index expression that becomes one
+ */
+func (s *Serializer) computedExpression(t *generic.TableDef, e *generic.Expression, column string, subject string, extras *tableExtras) (string, bool) {
+	tr := s.translator(t, subject, extras)
+	tr.inlining = []string{column}
+	return tr.translate(e)
+}
+
+/* Default of a column of sql server type _type, strings going into national types are written as N'...' */
+func (s *Serializer) defaultExpression(t *generic.TableDef, c *generic.ColumnDef, _type string, extras *tableExtras) string {
+	tr := s.translator(t, "default of column "+c.Name, extras)
+	tr.national = isNational(_type)
+	result, _ := tr.translate(c.Default)
+	return result
+}
+
+func (s *Serializer) translator(t *generic.TableDef, subject string, extras *tableExtras) *translator {
+	return &translator{s: s, table: t, subject: subject, extras: extras, deterministic: true}
+}
+
+func (tr *translator) translate(e *generic.Expression) (string, bool) {
+	if e.Tree == nil {
+		tr.extras.note("expression of %s couldn't be read and was passed through as written, check it is valid t-sql: %s", tr.subject, e.Text)
+		return e.Text, false
+	}
+	result := tr.expr(e.Tree)
+	if tr.unknown != "" {
+		tr.extras.note("expression of %s contains %s which can't be translated, it was passed through as written, check it is valid t-sql: %s", tr.subject, tr.unknown, e.Text)
+		return e.Text, false
+	}
+	return result, tr.deterministic
+}
+
+/* Tells if a sql server type holds unicode text */
+func isNational(_type string) bool {
+	upper := strings.ToUpper(_type)
+	return strings.HasPrefix(upper, "NVARCHAR") || strings.HasPrefix(upper, "NCHAR") || strings.HasPrefix(upper, "NTEXT")
+}
+
+func (tr *translator) note(format string, a ...any) {
+	tr.extras.note("%s: %s", tr.subject, fmt.Sprintf(format, a...))
+}
+
+func (tr *translator) list(es []generic.Expr) []string {
+	results := []string{}
+	for _, e := range es {
+		results = append(results, tr.expr(e))
+	}
+	return results
+}
+
+func (tr *translator) expr(e generic.Expr) string {
+	switch e := e.(type) {
+	case *generic.LiteralExpr:
+		switch e.Kind {
+		case generic.LITERAL_STRING:
+			quoted := "'" + strings.ReplaceAll(e.Value, "'", "''") + "'"
+			if tr.national {
+				return "N" + quoted
+			}
+			return quoted
+		case generic.LITERAL_NULL:
+			return "NULL"
+		}
+		return e.Value
+	case *generic.NameExpr:
+		return tr.name(e)
+	case *generic.FunctionExpr:
+		return tr.function(e)
+	case *generic.BinaryExpr:
+		switch e.Op {
+		case "||":
+			return "CONCAT(" + strings.Join(tr.list(concatOperands(e)), ", ") + ")"
+		case "!=", "^=":
+			return tr.expr(e.Left) + " <> " + tr.expr(e.Right)
+		case "+", "-":
+			if result, ok := tr.dateArithmetic(e); ok {
+				return result
+			}
+		case "/":
+			return tr.divide(e)
+		}
+		return tr.expr(e.Left) + " " + e.Op + " " + tr.expr(e.Right)
+	case *generic.UnaryExpr:
+		if e.Op == "NOT" {
+			return "NOT " + tr.expr(e.Operand)
+		}
+		return prefix(e.Op, tr.expr(e.Operand))
+	case *generic.ParenExpr:
+		return "(" + tr.expr(e.Inner) + ")"
+	case *generic.CaseExpr:
+		parts := []string{"CASE"}
+		if e.Operand != nil {
+			parts = append(parts, tr.expr(e.Operand))
+		}
+		for _, when := range e.Whens {
+			parts = append(parts, "WHEN", tr.expr(when.When), "THEN", tr.expr(when.Then))
+		}
+		if e.Else != nil {
+			parts = append(parts, "ELSE", tr.expr(e.Else))
+		}
+		return strings.Join(append(parts, "END"), " ")
+	case *generic.IsNullExpr:
+		if e.Not {
+			return tr.expr(e.Operand) + " IS NOT NULL"
+		}
+		return tr.expr(e.Operand) + " IS NULL"
+	case *generic.InExpr:
+		op := " IN "
+		if e.Not {
+			op = " NOT IN "
+		}
+		return tr.expr(e.Operand) + op + "(" + strings.Join(tr.list(e.List), ", ") + ")"
+	case *generic.BetweenExpr:
+		op := " BETWEEN "
+		if e.Not {
+			op = " NOT BETWEEN "
+		}
+		return tr.expr(e.Operand) + op + tr.expr(e.Low) + " AND " + tr.expr(e.High)
+	}
+	tr.unknown = strings.TrimPrefix(fmt.Sprintf("%T", e), "*generic.")
+	return ""
+}
+
+/* Puts a sign in front of an operand, -- would start a comment in t-sql so a second sign is kept apart */
+func prefix(sign string, operand string) string {
+	if strings.HasPrefix(operand, "-") || strings.HasPrefix(operand, "+") {
+		return sign + " " + operand
+	}
+	return sign + operand
+}
+
+/* Flattens a || b || c into its operands, oracle treats NULL as an empty string here just like CONCAT */
+func concatOperands(e generic.Expr) []generic.Expr {
+	if b, ok := e.(*generic.BinaryExpr); ok && b.Op == "||" {
+		return append(concatOperands(b.Left), concatOperands(b.Right)...)
+	}
+	return []generic.Expr{e}
+}
+
+/* Pseudo columns and functions that are dates or timestamps in oracle */
+var datePseudoColumns = []string{"CURRENT_DATE", "CURRENT_TIMESTAMP", "LOCALTIMESTAMP", "SYSDATE", "SYSTIMESTAMP"}
+var dateFunctions = []string{"ADD_MONTHS", "LAST_DAY", "NEXT_DAY", "TO_DATE", "TO_TIMESTAMP"}
+
+/* Tells if an expression is a date or timestamp in oracle, as far as that can be known without running it */
+func (tr *translator) isDate(e generic.Expr) bool {
+	switch e := e.(type) {
+	case *generic.NameExpr:
+		last := e.Parts[len(e.Parts)-1]
+		if len(e.Parts) == 1 && !last.Quoted && slices.Contains(datePseudoColumns, strings.ToUpper(last.Name)) {
+			return true
+		}
+		if tr.table == nil {
+			return false
+		}
+		c := tr.table.Columns.Get(last.Normalized())
+		return c != nil && (c.Type.Name == "DATE" || c.Type.Name == "TIMESTAMP")
+	case *generic.FunctionExpr:
+		name := e.Name.Object.Normalized()
+		if slices.Contains(dateFunctions, name) {
+			return true
+		}
+		// TRUNC and ROUND of a date are dates
+		return (name == "TRUNC" || name == "ROUND") && len(e.Args) > 0 && tr.isDate(e.Args[0])
+	case *generic.ParenExpr:
+		return tr.isDate(e.Inner)
+	case *generic.BinaryExpr:
+		left, right := tr.isDate(e.Left), tr.isDate(e.Right)
+		return (e.Op == "+" || e.Op == "-") && left != right && (left || e.Op == "+")
+	}
+	return false
+}
+
+/* Rewrites date arithmetic, oracle adds and subtracts days while t-sql can't add numbers to DATETIME2
+ * date ± n becomes DATEADD and date - date the difference in days
+ */
+func (tr *translator) dateArithmetic(e *generic.BinaryExpr) (string, bool) {
+	left, right := tr.isDate(e.Left), tr.isDate(e.Right)
+	switch {
+	case left && right && e.Op == "-":
+		return fmt.Sprintf("DATEDIFF_BIG(second, %s, %s) / 86400.0", tr.expr(e.Right), tr.expr(e.Left)), true
+	case left && !right:
+		return tr.addDays(tr.expr(e.Left), e.Right, e.Op == "-"), true
+	case right && !left && e.Op == "+":
+		return tr.addDays(tr.expr(e.Right), e.Left, false), true
+	}
+	return "", false
+}
+
+/* Adds a number of days to a date, whole days with DATEADD(day) and anything else in seconds */
+func (tr *translator) addDays(date string, days generic.Expr, subtract bool) string {
+	n := tr.expr(days)
+	if isIntegerLiteral(days) {
+		if subtract {
+			n = prefix("-", n)
+		}
+		return fmt.Sprintf("DATEADD(day, %s, %s)", n, date)
+	}
+	// DATEADD drops the fraction of its number, so fractions of a day go in as seconds
+	if !isOperand(days) {
+		n = "(" + n + ")"
+	}
+	if subtract {
+		n = prefix("-", n)
+	}
+	return fmt.Sprintf("DATEADD(second, %s * 86400, %s)", n, date)
+}
+
+/* Nodes that can be used next to an operator without parentheses */
+func isOperand(e generic.Expr) bool {
+	switch e.(type) {
+	case *generic.LiteralExpr, *generic.NameExpr, *generic.FunctionExpr, *generic.ParenExpr:
+		return true
+	}
+	return false
+}
+
+func isIntegerLiteral(e generic.Expr) bool {
+	lit, ok := e.(*generic.LiteralExpr)
+	return ok && lit.Kind == generic.LITERAL_NUMBER && !strings.ContainsAny(lit.Value, ".eE")
+}
+
+/* Tells if an expression may be an integer in sql server, false only when it is known not to be */
+func (tr *translator) mayBeInteger(e generic.Expr) bool {
+	switch e := e.(type) {
+	case *generic.LiteralExpr:
+		return isIntegerLiteral(e)
+	case *generic.NameExpr:
+		if tr.table == nil {
+			return true
+		}
+		c := tr.table.Columns.Get(e.Parts[len(e.Parts)-1].Normalized())
+		if c == nil {
+			return true
+		}
+		switch c.Type.Name {
+		case "INT", "INTEGER", "SMALLINT":
+			return true
+		case "NUMBER", "NUMERIC", "DECIMAL":
+			return c.Type.Precision > 0 && c.Type.Scale <= 0
+		}
+		return false
+	case *generic.ParenExpr:
+		return tr.mayBeInteger(e.Inner)
+	}
+	return true
+}
+
+/* Oracle always divides exactly, t-sql divides integers as integers
+ * an integer literal on either side is written as a decimal, divisions of possible integers are reported
+ */
+func (tr *translator) divide(e *generic.BinaryExpr) string {
+	left, right := tr.expr(e.Left), tr.expr(e.Right)
+	switch {
+	case isIntegerLiteral(e.Right):
+		right += ".0"
+	case isIntegerLiteral(e.Left):
+		left += ".0"
+	case tr.mayBeInteger(e.Left) && tr.mayBeInteger(e.Right):
+		tr.note("%s / %s divides integers as integers in sql server, oracle keeps the fraction", left, right)
+	}
+	return left + " / " + right
+}
+
+/* Oracle pseudo columns and their t-sql equivalents, none of them is deterministic */
+var pseudoColumns = map[string]string{
+	"CURRENT_DATE":      "SYSDATETIME()",
+	"CURRENT_TIMESTAMP": "SYSDATETIMEOFFSET()",
+	"LOCALTIMESTAMP":    "SYSDATETIME()",
+	"SYSDATE":           "SYSDATETIME()",
+	"SYSTIMESTAMP":      "SYSDATETIMEOFFSET()",
+	"USER":              "SUSER_SNAME()",
+}
+
+/* Oracle pseudo columns without a t-sql equivalent, they only make sense inside oracle queries */
+var oraclePseudoColumns = []string{"CONNECT_BY_ISCYCLE", "CONNECT_BY_ISLEAF", "CONNECT_BY_ROOT", "LEVEL", "ORA_ROWSCN", "ROWID", "ROWNUM"}
+
+/* USERENV parameters of SYS_CONTEXT and their t-sql equivalents */
+var userenvParameters = map[string]string{
+	"CURRENT_SCHEMA": "SCHEMA_NAME()",
+	"DB_NAME":        "DB_NAME()",
+	"HOST":           "HOST_NAME()",
+	"OS_USER":        "SUSER_SNAME()",
+	"SESSION_USER":   "SUSER_SNAME()",
+	"SID":            "@@SPID",
+}
+
+func (tr *translator) name(e *generic.NameExpr) string {
+	last := e.Parts[len(e.Parts)-1]
+	if len(e.Parts) == 1 && !last.Quoted {
+		if v, ok := pseudoColumns[strings.ToUpper(last.Name)]; ok {
+			tr.deterministic = false
+			return v
+		}
+		if name := strings.ToUpper(last.Name); slices.Contains(oraclePseudoColumns, name) {
+			tr.deterministic = false
+			tr.note("pseudo column %s has no t-sql equivalent and was passed through", name)
+			return name
+		}
+	}
+	// SEQ.NEXTVAL and SCHEMA.SEQ.NEXTVAL
+	if len(e.Parts) > 1 && !last.Quoted {
+		sequence := tr.s.QuoteName(generic.NewQualifiedName(e.Parts[:len(e.Parts)-1]...))
+		switch strings.ToUpper(last.Name) {
+		case "NEXTVAL":
+			tr.deterministic = false
+			return "NEXT VALUE FOR " + sequence
+		case "CURRVAL":
+			tr.deterministic = false
+			tr.note("CURRVAL of %s reads the last value of the sequence for every session, not just the current one", sequence)
+			return fmt.Sprintf("(SELECT current_value FROM sys.sequences WHERE object_id = OBJECT_ID('%s'))", strings.ReplaceAll(sequence, "'", "''"))
+		}
+	}
+	if result, ok := tr.inline(e); ok {
+		return result
+	}
+	parts := []string{}
+	for _, part := range e.Parts {
+		parts = append(parts, QuoteIdentifier(part.Normalized()))
+	}
+	return strings.Join(parts, ".")
+}
+
+/* Replaces a reference to a computed column by its expression while translating a computed column */
+func (tr *translator) inline(e *generic.NameExpr) (string, bool) {
+	if tr.inlining == nil || tr.table == nil || len(e.Parts) != 1 {
+		return "", false
+	}
+	c := tr.table.Columns.Get(e.Parts[0].Normalized())
+	if c == nil || c.Virtual == nil {
+		return "", false
+	}
+	if slices.Contains(tr.inlining, c.Name) {
+		tr.note("computed column %s is computed from itself, the reference was passed through", c.Name)
+		return "", false
+	}
+	if c.Virtual.Tree == nil {
+		tr.note("computed column %s couldn't be read to put in its place, sql server doesn't allow referencing it", c.Name)
+		return "", false
+	}
+	tr.inlining = append(tr.inlining, c.Name)
+	defer func() { tr.inlining = tr.inlining[:len(tr.inlining)-1] }()
+	inner := tr.expr(c.Virtual.Tree)
+	if c.Type.Name != "" {
+		if _type, err := tr.s.Types.Map(c); err == nil {
+			return "CAST(" + inner + " AS " + _type + ")", true
+		}
+	}
+	return "(" + inner + ")", true
+}
+
+/* Functions that keep their name and arguments */
+var sameFunctions = map[string]bool{
+	"ABS": true, "COALESCE": true, "FLOOR": true, "LOWER": true, "LTRIM": true, "NULLIF": true,
+	"POWER": true, "REPLACE": true, "RTRIM": true, "SIGN": true, "SQRT": true, "UPPER": true,
+}
+
+/* Functions that only change their name */
+var renamedFunctions = map[string]string{
+	"CEIL": "CEILING",
+	"NVL":  "ISNULL",
+}
+
+func (tr *translator) function(f *generic.FunctionExpr) string {
+	name := f.Name.Object.Normalized()
+	if f.Star {
+		return name + "(*)"
+	}
+	args := tr.list(f.Args)
+	call := func(name string, args ...string) string {
+		return name + "(" + strings.Join(args, ", ") + ")"
+	}
+	if f.Name.Schema.Name != "" {
+		tr.deterministic = false
+		tr.note("function %s was passed through, it has to exist in sql server", f.Name)
+		return call(tr.s.QuoteName(f.Name), args...)
+	}
+	if sameFunctions[name] {
+		return call(name, args...)
+	}
+	if to, ok := renamedFunctions[name]; ok {
+		return call(to, args...)
+	}
+	switch {
+	case (name == "ROUND" || name == "TRUNC") && len(args) > 0 && tr.isDate(f.Args[0]):
+		return tr.roundDate(name, args)
+	case name == "LENGTH" && len(args) == 1:
+		tr.note("LENGTH became LEN, which doesn't count trailing spaces")
+		return call("LEN", args...)
+	case name == "SUBSTR" && (len(args) == 2 || len(args) == 3):
+		args[1] = tr.substrStart(f.Args[1], args[1])
+		if len(args) == 2 {
+			// the rest of the string, DATALENGTH is never shorter than it
+			args = append(args, call("DATALENGTH", args[0]))
+		}
+		return call("SUBSTRING", args...)
+	case name == "INSTR" && len(args) == 2:
+		return call("CHARINDEX", args[1], args[0])
+	case name == "MOD" && len(args) == 2:
+		return "(" + args[0] + " % " + args[1] + ")"
+	case name == "ROUND" && len(args) == 1:
+		return call("ROUND", args[0], "0")
+	case name == "ROUND" && len(args) == 2:
+		return call("ROUND", args...)
+	case name == "TRUNC" && len(args) == 1:
+		return call("ROUND", args[0], "0", "1")
+	case name == "TRUNC" && len(args) == 2:
+		return call("ROUND", args[0], args[1], "1")
+	case name == "NVL2" && len(args) == 3:
+		return fmt.Sprintf("CASE WHEN %s IS NOT NULL THEN %s ELSE %s END", args[0], args[1], args[2])
+	case name == "TO_CHAR" && len(args) == 1:
+		// dates convert with the default style, which isn't deterministic
+		tr.deterministic = false
+		return "CAST(" + args[0] + " AS VARCHAR(4000))"
+	case name == "TO_CHAR" && len(args) == 2:
+		return tr.toChar(f.Args[1], args[0], args[1])
+	case name == "TO_DATE" && len(args) == 1:
+		tr.deterministic = false
+		return fmt.Sprintf("CONVERT(%s, %s)", tr.dateType(), args[0])
+	case name == "TO_DATE" && len(args) == 2:
+		return tr.toDate(f.Args[1], args[0], args[1])
+	case name == "DECODE" && len(args) >= 3:
+		return tr.decode(f.Args)
+	case name == "SYS_GUID" && len(args) == 0:
+		tr.deterministic = false
+		return "NEWID()"
+	case name == "SYS_CONTEXT" && len(args) == 2:
+		return tr.sysContext(f.Args)
+	}
+	tr.deterministic = false
+	tr.note("function %s has no known t-sql equivalent and was passed through", name)
+	return call(name, args...)
+}
+
+/* SUBSTR treats a start of 0 like 1 and counts a negative one from the end, SUBSTRING does neither */
+func (tr *translator) substrStart(e generic.Expr, start string) string {
+	if lit, ok := e.(*generic.LiteralExpr); ok && isIntegerLiteral(lit) {
+		if strings.Trim(lit.Value, "0") == "" {
+			return "1"
+		}
+		return start
+	}
+	tr.note("SUBSTR start %s may be 0 or negative, oracle reads 0 as 1 and a negative start from the end of the string, SUBSTRING doesn't", start)
+	return start
+}
+
+/* ROUND and TRUNC of a date, to the day they are exact and otherwise passed through */
+func (tr *translator) roundDate(name string, args []string) string {
+	if len(args) == 1 {
+		if name == "TRUNC" {
+			return fmt.Sprintf("CAST(CAST(%s AS DATE) AS %s)", args[0], tr.dateType())
+		}
+		// noon and later rounds up to the next day
+		return fmt.Sprintf("CAST(CAST(DATEADD(hour, 12, %s) AS DATE) AS %s)", args[0], tr.dateType())
+	}
+	tr.deterministic = false
+	tr.note("%s of a date with format %s has no t-sql equivalent and was passed through", name, args[1])
+	return name + "(" + strings.Join(args, ", ") + ")"
+}
+
+/* DECODE compares like = except that NULL matches NULL
+ * without NULL searches it becomes a simple CASE, otherwise the searched form
+ */
+func (tr *translator) decode(es []generic.Expr) string {
+	isNull := func(e generic.Expr) bool {
+		lit, ok := e.(*generic.LiteralExpr)
+		return ok && lit.Kind == generic.LITERAL_NULL
+	}
+	searched := false
+	for i := 1; i+1 < len(es); i += 2 {
+		searched = searched || isNull(es[i])
+	}
+
+	value := tr.expr(es[0])
+	parts := []string{"CASE"}
+	if !searched {
+		parts = append(parts, value)
+	}
+	for i := 1; i+1 < len(es); i += 2 {
+		when := tr.expr(es[i])
+		switch {
+		case !searched:
+		case isNull(es[i]):
+			when = value + " IS NULL"
+		default:
+			when = value + " = " + when
+		}
+		parts = append(parts, "WHEN", when, "THEN", tr.expr(es[i+1]))
+	}
+	if len(es)%2 == 0 {
+		parts = append(parts, "ELSE", tr.expr(es[len(es)-1]))
+	}
+	return strings.Join(append(parts, "END"), " ")
+}
+
+/* SYS_CONTEXT('USERENV', ...) with a known parameter, anything else is passed through */
+func (tr *translator) sysContext(es []generic.Expr) string {
+	namespace, ok1 := es[0].(*generic.LiteralExpr)
+	parameter, ok2 := es[1].(*generic.LiteralExpr)
+	if ok1 && ok2 && strings.EqualFold(namespace.Value, "USERENV") {
+		if v, ok := userenvParameters[strings.ToUpper(parameter.Value)]; ok {
+			tr.deterministic = false
+			return v
+		}
+	}
+	tr.deterministic = false
+	tr.note("SYS_CONTEXT has no t-sql equivalent for these arguments and was passed through")
+	return "SYS_CONTEXT(" + strings.Join(tr.list(es), ", ") + ")"
+}
+
+/* The sql server type DATE maps to, following the type rules */
+func (tr *translator) dateType() string {
+	result, err := tr.s.Types.Map(&generic.ColumnDef{Type: generic.DataType{Name: "DATE"}})
+	if err != nil {
+		return "DATETIME2(0)"
+	}
+	return result
+}
+
+/* Converts TO_DATE with a format mask
+ * masks with a CONVERT style use it, others fall back to TRY_CONVERT which guesses the format
+ */
+func (tr *translator) toDate(maskExpr generic.Expr, value string, mask string) string {
+	if lit, ok := maskExpr.(*generic.LiteralExpr); ok && lit.Kind == generic.LITERAL_STRING {
+		if style, ok := dateStyles[strings.ToUpper(lit.Value)]; ok {
+			// parsing strings is only deterministic with the ISO styles
+			if style.Style != 120 && style.Style != 126 {
+				tr.deterministic = false
+			}
+			return fmt.Sprintf("CONVERT(%s, %s, %d)", tr.dateType(), value, style.Style)
+		}
+	}
+	tr.deterministic = false
+	tr.note("TO_DATE format mask %s has no CONVERT style, the value is converted with TRY_CONVERT and NULL when it doesn't parse", mask)
+	return fmt.Sprintf("TRY_CONVERT(%s, %s)", tr.dateType(), value)
+}
+
+/* A date format mask convert can produce with a style, Length cuts the output to the mask */
+type dateStyle struct {
+	Length int
+	Style  int
+}
+
+/* Oracle date masks with an equivalent CONVERT style, only deterministic styles are used */
+var dateStyles = map[string]dateStyle{
+	"YYYY-MM-DD":                {10, 120},
+	"YYYY-MM-DD HH24:MI:SS":     {19, 120},
+	"YYYY-MM-DD\"T\"HH24:MI:SS": {19, 126},
+	"YYYYMMDD":                  {8, 112},
+	"YYYY/MM/DD":                {10, 111},
+	"DD.MM.YYYY":                {10, 104},
+	"DD/MM/YYYY":                {10, 103},
+	"MM/DD/YYYY":                {10, 101},
+	"HH24:MI:SS":                {8, 114},
+}
+
+/* Oracle date mask elements and their .NET FORMAT counterparts, longer elements first */
+var dateMaskElements = []struct{ oracle, dotnet string }{
+	{"YYYY", "yyyy"}, {"MONTH", "MMMM"}, {"MON", "MMM"}, {"HH24", "HH"}, {"HH12", "hh"},
+	{"DAY", "dddd"}, {"YY", "yy"}, {"MM", "MM"}, {"DD", "dd"}, {"DY", "ddd"}, {"HH", "hh"},
+	{"MI", "mm"}, {"SS", "ss"}, {"AM", "tt"}, {"PM", "tt"},
+	{"FF1", "f"}, {"FF2", "ff"}, {"FF3", "fff"}, {"FF4", "ffff"}, {"FF5", "fffff"}, {"FF6", "ffffff"}, {"FF", "ffffff"},
+}
+
+/* Converts an oracle date mask to a .NET format string, ok is false when an element isn't known */
+func dateMask(mask string) (string, bool) {
+	var sb strings.Builder
+	upper := strings.ToUpper(mask)
+	for i := 0; i < len(upper); {
+		c := upper[i]
+		if c == '"' {
+			end := strings.IndexByte(upper[i+1:], '"')
+			if end < 0 {
+				return "", false
+			}
+			for _, r := range mask[i+1 : i+1+end] {
+				sb.WriteString("\\" + string(r))
+			}
+			i += end + 2
+			continue
+		}
+		if strings.IndexByte(" -/:.,;", c) >= 0 {
+			sb.WriteByte(c)
+			i++
+			continue
+		}
+		found := false
+		for _, el := range dateMaskElements {
+			if strings.HasPrefix(upper[i:], el.oracle) {
+				sb.WriteString(el.dotnet)
+				i += len(el.oracle)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", false
+		}
+	}
+	return sb.String(), true
+}
+
+/* Converts an oracle number mask like FM999,990.00 to a .NET format string, ok is false for other masks */
+func numberMask(mask string) (string, bool) {
+	mask = strings.TrimPrefix(strings.ToUpper(mask), "FM")
+	if mask == "" || strings.Trim(mask, "90,.") != "" {
+		return "", false
+	}
+	return strings.ReplaceAll(mask, "9", "#"), true
+}
+
+/* Converts TO_CHAR with a format mask
+ * masks with a deterministic CONVERT style use it, others go through FORMAT which can't be persisted
+ */
+func (tr *translator) toChar(maskExpr generic.Expr, value string, mask string) string {
+	lit, ok := maskExpr.(*generic.LiteralExpr)
+	if !ok || lit.Kind != generic.LITERAL_STRING {
+		tr.deterministic = false
+		tr.note("TO_CHAR with a computed format mask was passed to FORMAT as is, oracle and .NET masks differ")
+		return fmt.Sprintf("FORMAT(%s, %s)", value, mask)
+	}
+	if style, ok := dateStyles[strings.ToUpper(lit.Value)]; ok {
+		return fmt.Sprintf("CONVERT(VARCHAR(%d), %s, %d)", style.Length, value, style.Style)
+	}
+	tr.deterministic = false
+	if format, ok := numberMask(lit.Value); ok {
+		return fmt.Sprintf("FORMAT(%s, '%s')", value, format)
+	}
+	if format, ok := dateMask(lit.Value); ok {
+		return fmt.Sprintf("FORMAT(%s, '%s')", value, strings.ReplaceAll(format, "'", "''"))
+	}
+	tr.note("TO_CHAR format mask %s isn't known and was passed to FORMAT as is", mask)
+	return fmt.Sprintf("FORMAT(%s, %s)", value, mask)
+}
@@ -0,0 +1,194 @@
+package tsql
+
+import (
+	"strings"
+	"testing"
+	"tsqlgrl/generic"
+	"tsqlgrl/oracle"
+)
+
+/* Parses a script holding one CREATE TABLE and returns the table */
+func parseTable(t *testing.T, ddl string) *generic.TableDef {
+	t.Helper()
+	res, err := oracle.Parse("test.sql", []byte(ddl))
+	if err != nil {
+		t.Fatalf("parse %s: %v", ddl, err)
+	}
+	tables := generic.NewTablesDef(oracle.Origin)
+	if err := tables.Add(res.([]any)...); err != nil {
+		t.Fatalf("add %s: %v", ddl, err)
+	}
+	for _, table := range tables.Tables {
+		return table
+	}
+	t.Fatalf("no table in %s", ddl)
+	return nil
+}
+
+func noteTexts(extras *tableExtras) []string {
+	results := []string{}
+	for _, n := range extras.notes {
+		results = append(results, n.text)
+	}
+	return results
+}
+
+func checkNote(t *testing.T, extras *tableExtras, note string) {
+	t.Helper()
+	notes := noteTexts(extras)
+	if note == "" {
+		if len(notes) > 0 {
+			t.Errorf("unexpected notes %q", notes)
+		}
+		return
+	}
+	for _, n := range notes {
+		if strings.Contains(n, note) {
+			return
+		}
+	}
+	t.Errorf("no note containing %q in %q", note, notes)
+}
+
+const expressionColumns string = `"A" NUMBER, "S" VARCHAR2(20), "D" DATE, `
+
+func TestExpression(t *testing.T) {
+	tests := []struct {
+		expr          string
+		want          string
+		deterministic bool
+		// part of a note the translation has to add, empty when it must not add any
+		note string
+	}{
+		{`"A" + 1`, `[A] + 1`, true, ""},
+		{`-"A"`, `-[A]`, true, ""},
+		{`-(-1)`, `-(-1)`, true, ""},
+		{`- -1`, `- -1`, true, ""},
+		{`NVL("S", 'x')`, `ISNULL([S], 'x')`, true, ""},
+		{`MOD("A", 2)`, `([A] % 2)`, true, ""},
+		{`LENGTH("S")`, `LEN([S])`, true, "LENGTH became LEN"},
+		{`SUBSTR("S", 2, 3)`, `SUBSTRING([S], 2, 3)`, true, ""},
+		{`SUBSTR("S", 0, 3)`, `SUBSTRING([S], 1, 3)`, true, ""},
+		{`SUBSTR("S", 2)`, `SUBSTRING([S], 2, DATALENGTH([S]))`, true, ""},
+		{`SUBSTR("S", "A")`, `SUBSTRING([S], [A], DATALENGTH([S]))`, true, "may be 0 or negative"},
+		{`TRUNC("A")`, `ROUND([A], 0, 1)`, true, ""},
+		{`TRUNC("A", 2)`, `ROUND([A], 2, 1)`, true, ""},
+		{`TRUNC("D")`, `CAST(CAST([D] AS DATE) AS DATETIME2(0))`, true, ""},
+		{`ROUND("D")`, `CAST(CAST(DATEADD(hour, 12, [D]) AS DATE) AS DATETIME2(0))`, true, ""},
+		{`TRUNC("D", 'MM')`, `TRUNC([D], 'MM')`, false, "has no t-sql equivalent"},
+		{`"S" || 'x'`, `CONCAT([S], 'x')`, true, ""},
+		{`SYS_GUID()`, `NEWID()`, false, ""},
+		{`MY_FUNC("A")`, `MY_FUNC([A])`, false, "no known t-sql equivalent"},
+	}
+	s := NewSerializer()
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			table := parseTable(t, `CREATE TABLE "T" (`+expressionColumns+`"V" AS (`+test.expr+`));`)
+			extras := &tableExtras{}
+			got, deterministic := s.Expression(table, table.Columns.Get("V").Virtual, "test", extras)
+			if got != test.want || deterministic != test.deterministic {
+				t.Errorf("got %s, %v, want %s, %v", got, deterministic, test.want, test.deterministic)
+			}
+			checkNote(t, extras, test.note)
+		})
+	}
+}
+
+func TestComputedExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns string
+		column  string
+		want    string
+		note    string
+	}{
+		{
+			name:    "plain",
+			columns: `"A" NUMBER, "V" AS ("A" * 2)`,
+			column:  "V",
+			want:    `[A] * 2`,
+		},
+		{
+			name:    "inlined",
+			columns: `"A" NUMBER, "V" AS ("A" * 2), "W" AS ("V" + 1)`,
+			column:  "W",
+			want:    `([A] * 2) + 1`,
+		},
+		{
+			name:    "inlined with type",
+			columns: `"A" NUMBER, "V" NUMBER(10) AS ("A" * 2), "W" AS ("V" + 1)`,
+			column:  "W",
+			want:    `CAST([A] * 2 AS BIGINT) + 1`,
+		},
+		{
+			name:    "nested",
+			columns: `"A" NUMBER, "V" AS ("A" * 2), "W" AS ("V" + 1), "X" AS ("W" - "A")`,
+			column:  "X",
+			want:    `(([A] * 2) + 1) - [A]`,
+		},
+		{
+			name:    "cycle",
+			columns: `"V" AS ("W" + 1), "W" AS ("V" + 1)`,
+			column:  "W",
+			want:    `([W] + 1) + 1`,
+			note:    "computed from itself",
+		},
+	}
+	s := NewSerializer()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table := parseTable(t, `CREATE TABLE "T" (`+test.columns+`);`)
+			extras := &tableExtras{}
+			c := table.Columns.Get(test.column)
+			got, _ := s.computedExpression(table, c.Virtual, c.Name, "test", extras)
+			if got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+			checkNote(t, extras, test.note)
+		})
+	}
+}
+
+func TestDefaultExpression(t *testing.T) {
+	tests := []struct {
+		column string
+		want   string
+	}{
+		{`"C" VARCHAR2(10) DEFAULT 'x'`, `'x'`},
+		{`"C" NVARCHAR2(10) DEFAULT 'x'`, `N'x'`},
+		{`"C" NCHAR(1) DEFAULT 'it''s'`, `N'it''s'`},
+		{`"C" NVARCHAR2(10) DEFAULT 'a' || 'b'`, `CONCAT(N'a', N'b')`},
+		{`"C" NUMBER DEFAULT -1`, `-1`},
+	}
+	s := NewSerializer()
+	for _, test := range tests {
+		t.Run(test.column, func(t *testing.T) {
+			table := parseTable(t, `CREATE TABLE "T" (`+test.column+`);`)
+			c := table.Columns.Get("C")
+			_type, err := s.Types.Map(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.defaultExpression(table, c, _type, &tableExtras{}); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	tests := []struct {
+		sign, operand, want string
+	}{
+		{"-", "1", "-1"},
+		{"-", "-1", "- -1"},
+		{"-", "+1", "- +1"},
+		{"+", "-[A]", "+ -[A]"},
+		{"-", "([A])", "-([A])"},
+	}
+	for _, test := range tests {
+		if got := prefix(test.sign, test.operand); got != test.want {
+			t.Errorf("prefix(%q, %q) = %q, want %q", test.sign, test.operand, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package tsql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* Sql server permissions for an oracle object privilege, nil when there is no equivalent
+ * ALL is spelled out since sql server deprecated it
+ */
+var privilegeMap = map[string][]string{
+	"ALL":        {"SELECT", "INSERT", "UPDATE", "DELETE", "REFERENCES"},
+	"SELECT":     {"SELECT"},
+	"READ":       {"SELECT"},
+	"INSERT":     {"INSERT"},
+	"UPDATE":     {"UPDATE"},
+	"DELETE":     {"DELETE"},
+	"REFERENCES": {"REFERENCES"},
+	"ALTER":      {"ALTER"},
+	"EXECUTE":    {"EXECUTE"},
+}
+
+/* Sequence privileges differ, NEXT VALUE FOR needs UPDATE where oracle asks for SELECT */
+var sequencePrivilegeMap = map[string][]string{
+	"ALL":    {"ALTER", "UPDATE"},
+	"SELECT": {"UPDATE"},
+	"ALTER":  {"ALTER"},
+}
+
+/* Returns the sql server principal for an oracle grantee
+ * names in PrincipalMap are matched case insensitively, PUBLIC becomes the public role
+ */
+func (s *Serializer) Principal(who generic.NamePart) string {
+	name := who.Normalized()
+	if to, ok := s.PrincipalMap[strings.ToUpper(name)]; ok {
+		return to
+	}
+	if name == "PUBLIC" && !who.Quoted {
+		return "public"
+	}
+	return name
+}
+
+/* Converts a GRANT or REVOKE to its sql server statement
+ * returns an empty string when none of the privileges has an equivalent, extras explains why
+ */
+func (s *Serializer) Grant(g *generic.Grant, sequence bool, extras *tableExtras) (string, error) {
+	privileges := privilegeMap
+	if sequence {
+		privileges = sequencePrivilegeMap
+	}
+	names := []string{}
+	for _, p := range g.Privileges {
+		mapped, ok := privileges[p.Name]
+		if !ok {
+			extras.note("privilege %s on %s has no sql server equivalent and was left out", p.Name, g.Where)
+			continue
+		}
+		for _, name := range mapped {
+			if len(p.Columns) > 0 {
+				name += " (" + quoteList(p.Columns) + ")"
+			}
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return "", nil
+	}
+	if len(g.Who) == 0 {
+		return "", fmt.Errorf("grant on %s has no grantees", g.Where)
+	}
+
+	principals := []string{}
+	for _, who := range g.Who {
+		principals = append(principals, QuoteIdentifier(s.Principal(who)))
+	}
+
+	verb, prep, suffix := "GRANT", "TO", ""
+	if g.Revoke {
+		// oracle also revokes what the grantees passed on
+		verb, prep, suffix = "REVOKE", "FROM", " CASCADE"
+	} else if g.WithGrantOption {
+		suffix = " WITH GRANT OPTION"
+	}
+	return fmt.Sprintf("%s %s ON OBJECT::%s %s %s%s;", verb, strings.Join(names, ", "), s.QuoteName(g.Where), prep, strings.Join(principals, ", "), suffix), nil
+}
+
+/* Converts grants and revokes in script order as a single batch */
+func (s *Serializer) grants(d *generic.TablesDef) string {
+	return s.grantBatch(d, d.Grants)
+}
+
+/* Converts some of the grants of d as a single batch, d tells sequences from tables
+ * grants that can't be converted are left out and reported
+ */
+func (s *Serializer) grantBatch(d *generic.TablesDef, grants []*generic.Grant) string {
+	extras := &tableExtras{}
+	stmts := []string{}
+	for _, g := range grants {
+		_, sequence := d.Sequences[g.Where.String()]
+		extras.at = g.Position
+		stmt, err := s.Grant(g, sequence, extras)
+		if err != nil {
+			s.leaveOut(g.Position.Wrap(err), extras)
+			continue
+		}
+		if stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+	}
+	if len(stmts) == 0 && len(extras.notes) == 0 && len(extras.leftOut) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	s.writeLeftOut(&sb, extras.leftOut)
+	s.writeNotes(&sb, "grants", extras.notes)
+	sb.WriteString(s.statements(stmts))
+	return sb.String()
+}
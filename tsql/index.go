@@ -0,0 +1,126 @@
+package tsql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* Looks for a primary key or unique constraint that already creates the index in sql server
+ * either through USING INDEX or by covering the same columns
+ */
+func indexConstraint(t *generic.TableDef, idx *generic.IndexDef) *generic.ConstraintDef {
+	cons := slices.Clone(t.Constraints)
+	for _, c := range t.Columns {
+		cons = append(cons, c.Constraints...)
+	}
+	cols := []string{}
+	for _, c := range idx.Columns {
+		if c.Expression != nil || c.Descending {
+			cols = nil
+			break
+		}
+		cols = append(cols, c.Name)
+	}
+	for _, con := range cons {
+		if con.Kind != generic.CONSTRAINT_PRIMARY_KEY && con.Kind != generic.CONSTRAINT_UNIQUE {
+			continue
+		}
+		if con.State.Disabled {
+			continue
+		}
+		if con.State.UsingIndex != nil && !con.State.UsingIndex.Name.IsZero() && con.State.UsingIndex.Name.Object.Normalized() == idx.Name.Object.Normalized() {
+			return con
+		}
+		if idx.Unique && cols != nil && slices.Equal(con.Columns, cols) {
+			return con
+		}
+	}
+	return nil
+}
+
+/* Converts an index to its CREATE INDEX statement
+ * expressions of function based indexes are indexed through computed columns added first,
+ * an index on an expression sql server can't persist is left out
+ */
+func (s *Serializer) Index(t *generic.TableDef, idx *generic.IndexDef, extras *tableExtras) ([]string, error) {
+	defer extras.within(idx.Position)()
+	name := idx.Name.Object.Normalized()
+	if len(idx.Columns) == 0 {
+		return nil, idx.Position.Wrap(fmt.Errorf("index %s has no columns", idx.Name))
+	}
+	if con := indexConstraint(t, idx); con != nil {
+		extras.note("index %s was left out, sql server creates it for %s constraint %s", name, con.Kind, constraintName(t, con))
+		return nil, nil
+	}
+
+	table := s.QuoteName(t.Name)
+	results := []string{}
+	cols := []string{}
+	for i, c := range idx.Columns {
+		col := c.Name
+		if c.Expression != nil {
+			col = fmt.Sprintf("%s_C%d", name, i+1)
+			expr, deterministic := s.computedExpression(t, c.Expression, "", "function based index "+name, extras)
+			if !deterministic {
+				extras.note("function based index %s was left out, %s isn't deterministic in sql server and can't be indexed", name, c.Expression.Text)
+				return nil, nil
+			}
+			extras.note("function based index %s: %s is indexed through computed column %s", name, c.Expression.Text, col)
+			results = append(results, fmt.Sprintf("ALTER TABLE %s ADD %s AS (%s);", table, QuoteIdentifier(col), expr))
+		}
+		col = QuoteIdentifier(col)
+		if c.Descending {
+			col += " DESC"
+		}
+		cols = append(cols, col)
+	}
+	if idx.Bitmap {
+		extras.note("bitmap index %s has no sql server equivalent, it is created as a nonclustered index", name)
+	}
+	s.indexOptions(idx, extras)
+
+	unique := ""
+	if idx.Unique {
+		unique = "UNIQUE "
+	}
+	// memory optimized tables only take indexes through ALTER TABLE
+	if s.memoryOptimized(t) {
+		if idx.Unique {
+			results = append(results, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s UNIQUE NONCLUSTERED (%s);", table, QuoteIdentifier(name), strings.Join(cols, ", ")))
+		} else {
+			results = append(results, fmt.Sprintf("ALTER TABLE %s ADD INDEX %s NONCLUSTERED (%s);", table, QuoteIdentifier(name), strings.Join(cols, ", ")))
+		}
+		return results, nil
+	}
+	filegroup := ""
+	if fg := s.Filegroup(idx.Tablespace, "index "+name, extras); fg != "" {
+		filegroup = " ON " + QuoteIdentifier(fg)
+	}
+	results = append(results, fmt.Sprintf("CREATE %sNONCLUSTERED INDEX %s ON %s (%s)%s;", unique, QuoteIdentifier(name), table, strings.Join(cols, ", "), filegroup))
+	return results, nil
+}
+
+/* Reports the options of an index that are left out, storage tuning is left to sql server like for tables */
+func (s *Serializer) indexOptions(idx *generic.IndexDef, extras *tableExtras) {
+	name := idx.Name.Object.Normalized()
+	for _, o := range idx.Options {
+		switch {
+		case o.Name == "REVERSE":
+			extras.note("reverse key index %s has no sql server equivalent, it is created as a regular index", name)
+		case o.Name == "LOCAL" && o.Value == "":
+			// sql server aligns indexes with their table's partitions unless told otherwise
+		case o.Name == "LOCAL":
+			extras.note("LOCAL index %s follows the partitioning of its table in sql server, its own partitions %s were left out", name, o.Value)
+		case o.Name == "COMPRESS":
+			extras.note("%s of index %s is key prefix compression, sql server only compresses whole pages so it was left out", o, name)
+		case slices.Contains(ignoredPhysicalOptions, o.Name):
+		default:
+			extras.note("%s of index %s has no sql server equivalent and was left out", o, name)
+		}
+	}
+	if idx.Unparsed != "" {
+		extras.note("clause %s of index %s was not converted", idx.Unparsed, name)
+	}
+}
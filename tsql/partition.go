@@ -0,0 +1,122 @@
+package tsql
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+var dateBound = regexp.MustCompile(`(?is)^(?:TO_DATE\s*\(\s*|TIMESTAMP\s*|DATE\s*)'\s*(\d{4}-\d{2}-\d{2}[^']*?)\s*'`)
+var numberBound = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+var stringBound = regexp.MustCompile(`^'(?:[^']|'')*'$`)
+
+/* Converts an oracle range partition bound to a sql server literal
+ * reports false when the bound is passed through as written
+ */
+func partitionBound(bound string) (string, bool) {
+	if m := dateBound.FindStringSubmatch(bound); m != nil {
+		return "'" + m[1] + "'", true
+	}
+	if numberBound.MatchString(bound) || stringBound.MatchString(bound) {
+		return bound, true
+	}
+	return bound, false
+}
+
+/* Converts range partitioning to a partition function and scheme created before the table
+ * oracle partitions hold values LESS THAN their bound, which is sql server's RANGE RIGHT
+ * returns the ON scheme(column) clause, or an empty string when the table stays unpartitioned
+ */
+func (s *Serializer) partitioning(t *generic.TableDef, filegroup string, extras *tableExtras) string {
+	p := t.Physical.Partitioning
+	subject := "table " + t.Name.String()
+	switch p.Kind {
+	case generic.PARTITION_RANGE:
+	case generic.PARTITION_HASH:
+		extras.note("hash partitioning of %s has no sql server equivalent, the table is created unpartitioned. a persisted computed column like ABS(CHECKSUM(%s)) %% n can be range partitioned instead", subject, strings.Join(p.Columns, ", "))
+		return ""
+	default:
+		extras.note("%s partitioning of %s has no sql server equivalent, the table is created unpartitioned", p.Kind, subject)
+		return ""
+	}
+	if len(p.Columns) != 1 {
+		extras.note("range partitioning of %s uses %d columns, sql server partitions on a single column so the table is created unpartitioned", subject, len(p.Columns))
+		return ""
+	}
+	if len(p.Partitions) == 0 {
+		extras.note("range partitioning of %s declares no partitions, the table is created unpartitioned", subject)
+		return ""
+	}
+	col := t.Columns.Get(p.Columns[0])
+	if col == nil {
+		extras.note("partition column %s isn't a column of %s, the table is created unpartitioned", p.Columns[0], subject)
+		return ""
+	}
+	colType, err := s.Types.Map(col)
+	if err != nil {
+		extras.note("partition column %s of %s can't be converted, the table is created unpartitioned: %s", col.Name, subject, err)
+		return ""
+	}
+	if p.Interval != "" {
+		extras.note("INTERVAL (%s) of %s creates partitions on demand, sql server needs ALTER PARTITION FUNCTION ... SPLIT RANGE for each new range", p.Interval, subject)
+	}
+	if p.Subpartitioning != "" {
+		extras.note("%s of %s was left out, sql server has a single partitioning level", p.Subpartitioning, subject)
+	}
+	if pk := t.PrimaryKey(); pk != nil && !slices.Contains(pk.Columns, col.Name) {
+		extras.note("primary key %s of %s doesn't contain partition column %s, sql server requires it in the unique indexes of a partitioned table", constraintName(t, pk), subject, col.Name)
+	}
+
+	if filegroup == "" {
+		filegroup = "PRIMARY"
+	}
+	bounds := []string{}
+	filegroups := []string{}
+	unbounded := false
+	for _, part := range p.Partitions {
+		fg := filegroup
+		if part.Physical != nil {
+			if mapped := s.Filegroup(part.Physical.Tablespace, "partition "+part.Name+" of "+subject, extras); mapped != "" {
+				fg = mapped
+			}
+		}
+		filegroups = append(filegroups, fg)
+		if len(part.Values) == 1 && strings.EqualFold(part.Values[0], "MAXVALUE") {
+			unbounded = true
+			continue
+		}
+		if len(part.Values) != 1 {
+			extras.note("partition %s of %s has %d bounds, only the first is used", part.Name, subject, len(part.Values))
+		}
+		bound, ok := partitionBound(part.Values[0])
+		if !ok {
+			extras.note("bound %s of partition %s of %s is passed through as written, check it is valid t-sql", bound, part.Name, subject)
+		}
+		bounds = append(bounds, bound)
+	}
+	if !unbounded {
+		// RANGE RIGHT always has a partition past the last bound
+		extras.note("%s rejects values past its last partition bound, sql server keeps them in an extra partition", subject)
+		filegroups = append(filegroups, filegroup)
+	}
+
+	name := t.Name.Object.Normalized()
+	if t.Name.Schema.Name != "" {
+		name = t.Name.Schema.Normalized() + "_" + name
+	}
+	function := QuoteIdentifier("PF_" + name)
+	scheme := QuoteIdentifier("PS_" + name)
+	to := "ALL TO (" + QuoteIdentifier(filegroups[0]) + ")"
+	if slices.ContainsFunc(filegroups, func(fg string) bool { return fg != filegroups[0] }) {
+		to = "TO (" + quoteList(filegroups) + ")"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "CREATE PARTITION FUNCTION %s (%s)\n%sAS RANGE RIGHT FOR VALUES (%s);\n", function, colType, s.Indent, strings.Join(bounds, ", "))
+	fmt.Fprintf(&sb, "CREATE PARTITION SCHEME %s\n%sAS PARTITION %s %s;\n", scheme, s.Indent, function, to)
+	s.writeBatchEnd(&sb)
+	extras.before = append(extras.before, sb.String())
+	return " ON " + scheme + "(" + QuoteIdentifier(col.Name) + ")"
+}
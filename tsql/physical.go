@@ -0,0 +1,117 @@
+package tsql
+
+import (
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* Physical options that only tune oracle block, extent or segment management
+ * sql server manages these itself so they are dropped without a warning
+ */
+var ignoredPhysicalOptions = []string{
+	"PCTFREE", "PCTUSED", "INITRANS", "MAXTRANS", "STORAGE", "PCTTHRESHOLD",
+	"LOGGING", "NOCOMPRESS", "NOCACHE", "NOPARALLEL", "MONITORING", "NOMONITORING", "NOROWDEPENDENCIES",
+	"SEGMENT CREATION IMMEDIATE", "SEGMENT CREATION DEFERRED", "ENABLE ROW MOVEMENT", "DISABLE ROW MOVEMENT",
+	"NO INMEMORY", "COMPUTE STATISTICS",
+}
+
+/* Returns the filegroup for an oracle tablespace, empty to keep the default filegroup
+ * unmapped tablespaces are only reported when a mapping was configured
+ */
+func (s *Serializer) Filegroup(tablespace string, subject string, extras *tableExtras) string {
+	if tablespace == "" {
+		return ""
+	}
+	if to, ok := s.Filegroups[strings.ToUpper(tablespace)]; ok {
+		return to
+	}
+	if s.Filegroups != nil {
+		extras.note("tablespace %s of %s has no filegroup mapping, the default filegroup is used", tablespace, subject)
+	}
+	return ""
+}
+
+/* Converts the physical options of a table to the clauses following CREATE TABLE (...)
+ * TABLESPACE becomes ON [filegroup], a LOB tablespace TEXTIMAGE_ON and table COMPRESS page compression
+ */
+func (s *Serializer) TablePhysical(t *generic.TableDef, extras *tableExtras) string {
+	if s.memoryOptimized(t) {
+		return " WITH (MEMORY_OPTIMIZED = ON, DURABILITY = SCHEMA_ONLY)"
+	}
+	p := t.Physical
+	if p == nil {
+		return ""
+	}
+	subject := "table " + t.Name.String()
+	result := ""
+	filegroup := s.Filegroup(p.Tablespace, subject, extras)
+	textImage := ""
+	for _, lob := range p.Lobs {
+		// in row storage, chunk size, retention, deduplication and compression are all up to sql server
+		if options := strings.TrimSpace(lob.Kind + " " + lob.Parameters); options != "" {
+			extras.note("LOB %s of %s: %s has no sql server equivalent and was left out", strings.Join(lob.Columns, ", "), subject, options)
+		}
+		fg := s.Filegroup(lob.Tablespace, "LOB "+strings.Join(lob.Columns, ", ")+" of "+subject, extras)
+		if textImage == "" {
+			textImage = fg
+		} else if fg != "" && fg != textImage {
+			extras.note("LOB %s of %s can't have its own filegroup, all LOBs of a table go to %s", strings.Join(lob.Columns, ", "), subject, textImage)
+		}
+	}
+	scheme := ""
+	if p.Partitioning != nil {
+		scheme = s.partitioning(t, filegroup, extras)
+	}
+	if scheme != "" {
+		result += scheme
+		if textImage != "" {
+			extras.note("LOBs of partitioned %s follow the partition scheme, filegroup %s isn't used", subject, textImage)
+		}
+	} else {
+		if filegroup != "" {
+			result += " ON " + QuoteIdentifier(filegroup)
+		}
+		if textImage != "" {
+			result += " TEXTIMAGE_ON " + QuoteIdentifier(textImage)
+		}
+	}
+
+	switch p.Organization {
+	case "", "HEAP", "INDEX":
+		// index organized tables get a clustered primary key, see Constraint
+	default:
+		extras.note("ORGANIZATION %s of %s has no sql server equivalent", p.Organization, subject)
+	}
+
+	options := []string{}
+	for _, o := range p.Options {
+		switch {
+		case o.Name == "COMPRESS" && isDigits(o.Value):
+			// COMPRESS n is prefix compression of an index organized table's key
+			extras.note("%s of %s is key prefix compression, sql server only compresses whole pages so it was left out", o, subject)
+		case o.Name == "COMPRESS":
+			if strings.HasPrefix(o.Value, "FOR QUERY") || strings.HasPrefix(o.Value, "FOR ARCHIVE") {
+				extras.note("hybrid columnar COMPRESS %s of %s is converted to page compression, a clustered columnstore index may fit better", o.Value, subject)
+			}
+			options = append(options, "DATA_COMPRESSION = PAGE")
+		case o.Name == "OVERFLOW" && p.Organization == "INDEX":
+			// sql server stores wide rows off page on its own
+		case slices.Contains(ignoredPhysicalOptions, o.Name):
+		default:
+			extras.note("%s of %s has no sql server equivalent and was left out", o, subject)
+		}
+	}
+	if len(options) > 0 {
+		result += " WITH (" + strings.Join(options, ", ") + ")"
+	}
+
+	for _, clause := range p.Unparsed {
+		extras.note("clause %s of %s was not converted", clause, subject)
+	}
+	return result
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
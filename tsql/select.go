@@ -0,0 +1,257 @@
+package tsql
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+	"unicode"
+)
+
+/* Oracle constructs sql server doesn't understand, matched against the query with string literals blanked out */
+var oracleOnlySyntax = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{"(+) outer joins", regexp.MustCompile(`\(\s*\+\s*\)`)},
+	{"CONNECT BY", regexp.MustCompile(`(?i)\bCONNECT\s+BY\b`)},
+	{"START WITH", regexp.MustCompile(`(?i)\bSTART\s+WITH\b`)},
+	{"ROWNUM", regexp.MustCompile(`(?i)\bROWNUM\b`)},
+	{"ROWID", regexp.MustCompile(`(?i)\bROWID\b`)},
+	{"DUAL", regexp.MustCompile(`(?i)\bDUAL\b`)},
+	{"MINUS", regexp.MustCompile(`(?i)\bMINUS\b`)},
+	{"|| concatenation", regexp.MustCompile(`\|\|`)},
+	{"sequence NEXTVAL / CURRVAL", regexp.MustCompile(`(?i)\.\s*(NEXTVAL|CURRVAL)\b`)},
+	{"SYSDATE / SYSTIMESTAMP", regexp.MustCompile(`(?i)\bSYS(DATE|TIMESTAMP)\b`)},
+	{"oracle functions", regexp.MustCompile(`(?i)\b(DECODE|NVL2?|TO_CHAR|TO_DATE|TO_NUMBER|TRUNC|ADD_MONTHS|LISTAGG|SYS_GUID|INSTR|SUBSTR)\s*\(`)},
+}
+
+var stringLiteral = regexp.MustCompile(`'(?:[^']|'')*'`)
+
+/* Lists the oracle only constructs a query uses */
+func OracleOnlySyntax(query string) []string {
+	query = stringLiteral.ReplaceAllString(query, "''")
+	results := []string{}
+	for _, syntax := range oracleOnlySyntax {
+		if syntax.pattern.MatchString(query) {
+			results = append(results, syntax.name)
+		}
+	}
+	return results
+}
+
+/* Finds a keyword outside of parens and quotes, returns -1 when there is none */
+func topLevelKeyword(query string, keyword string) int {
+	depth := 0
+	quote := rune(0)
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$' || r == '#'
+	}
+	for i, r := range query {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case depth == 0 && len(query) >= i+len(keyword) && strings.EqualFold(query[i:i+len(keyword)], keyword):
+			before := i == 0 || !isWord(rune(query[i-1]))
+			after := i+len(keyword) == len(query) || !isWord(rune(query[i+len(keyword)]))
+			if before && after {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// keywords ending the table list of a FROM, join conditions don't since a comma after them starts another table
+var fromListEnd = []string{"CONNECT", "EXCEPT", "FETCH", "GROUP", "HAVING", "INTERSECT", "MINUS", "ORDER", "START", "UNION", "WHERE"}
+
+/* Quotes the tables a query reads and maps their schemas like every other name
+ * a table is the name after FROM, JOIN or a comma of a FROM list, at any depth so subqueries are covered too
+ * inFrom tells that query starts inside a FROM list, right where a table goes
+ * tables behind a database link are left as written
+ */
+func (s *Serializer) tableReferences(query string, inFrom bool) string {
+	isWord := func(c byte) bool {
+		return c == '_' || c == '$' || c == '#' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+	}
+	// whether the FROM list is open, per paren depth
+	from := []bool{inFrom}
+	expectTable := inFrom
+	var sb strings.Builder
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'':
+			// '' inside a literal is a quote
+			end := i + 1
+			for end < len(query) {
+				if query[end] == '\'' {
+					if end+1 < len(query) && query[end+1] == '\'' {
+						end += 2
+						continue
+					}
+					break
+				}
+				end++
+			}
+			end = min(end+1, len(query))
+			sb.WriteString(query[i:end])
+			i = end
+			continue
+		case c == '(':
+			from = append(from, false)
+			expectTable = false
+		case c == ')':
+			if len(from) > 1 {
+				from = from[:len(from)-1]
+			}
+		case c == ',':
+			expectTable = from[len(from)-1]
+		case c == '"' || isWord(c):
+			// a dotted name, each part quoted or not
+			parts := []generic.NamePart{}
+			end := i
+			for end < len(query) {
+				if query[end] == '"' {
+					n := strings.IndexByte(query[end+1:], '"')
+					if n < 0 {
+						break
+					}
+					parts = append(parts, generic.NamePart{Name: query[end+1 : end+1+n], Quoted: true})
+					end += n + 2
+				} else {
+					start := end
+					for end < len(query) && isWord(query[end]) {
+						end++
+					}
+					if start == end {
+						break
+					}
+					parts = append(parts, generic.NamePart{Name: query[start:end]})
+				}
+				if end >= len(query) || query[end] != '.' {
+					break
+				}
+				end++
+			}
+			if end == i {
+				end = i + 1
+			}
+			text := query[i:end]
+			word := strings.ToUpper(text)
+			dbLink := end < len(query) && query[end] == '@'
+			switch {
+			case expectTable && len(parts) > 0 && len(parts) <= 2 && !dbLink && word != "DUAL":
+				text = s.QuoteName(generic.NewQualifiedName(parts...))
+				expectTable = false
+			case word == "FROM" || word == "JOIN":
+				from[len(from)-1] = true
+				expectTable = true
+			case slices.Contains(fromListEnd, word):
+				from[len(from)-1] = false
+				expectTable = false
+			default:
+				expectTable = false
+			}
+			sb.WriteString(text)
+			i = end
+			continue
+		case !unicode.IsSpace(rune(c)):
+			expectTable = false
+		}
+		sb.WriteByte(c)
+		i++
+	}
+	return sb.String()
+}
+
+/* Rebuilds a parsed query with its expressions translated
+ * sql server needs a name for every column, unnamed expressions get the name oracle would give them
+ */
+func (s *Serializer) selectQuery(t *generic.TableDef, extras *tableExtras) string {
+	q := t.Select
+	subject := "query of table " + t.Name.String()
+	items := []string{}
+	for i, item := range q.Items {
+		if item.Star != "" {
+			items = append(items, item.Star)
+			continue
+		}
+		expr, _ := s.Expression(nil, item.Expression, subject, extras)
+		alias := item.Alias
+		// pseudo columns and sequences are names too but translate to expressions
+		name, isColumn := item.Expression.Tree.(*generic.NameExpr)
+		isColumn = isColumn && strings.HasSuffix(expr, QuoteIdentifier(name.Parts[len(name.Parts)-1].Normalized()))
+		if alias.Name == "" && !isColumn && len(t.SelectColumns) == 0 {
+			alias = generic.NamePart{Name: strings.Join(strings.Fields(item.Expression.Text), "")}
+			extras.note("column %d of the query of table %s has no alias, it is named %s like in oracle", i+1, t.Name, alias.Normalized())
+		}
+		if alias.Name != "" {
+			expr += " AS " + QuoteIdentifier(alias.Normalized())
+		}
+		items = append(items, expr)
+	}
+
+	result := "SELECT "
+	if q.Distinct {
+		result += "DISTINCT "
+	}
+	result += strings.Join(items, ", ") + "\nFROM " + s.tableReferences(q.From, true)
+	if q.Where != nil {
+		where, _ := s.Expression(nil, q.Where, subject, extras)
+		result += "\nWHERE " + where
+	}
+	return result + s.tableReferences(q.Rest, false)
+}
+
+/* Converts CREATE TABLE ... AS SELECT to SELECT ... INTO
+ * the column types are only known to the query so sql server has to create the table from it
+ * a column alias list wraps the query in a derived table that renames its columns
+ */
+func (s *Serializer) SelectInto(t *generic.TableDef, extras *tableExtras) (string, error) {
+	query := strings.TrimSpace(t.SelectStatement)
+	if query == "" {
+		return "", fmt.Errorf("table %s has an empty select statement", t.Name)
+	}
+	// translated parts of a parsed query are t-sql already, only the parts kept as written are checked
+	written := query
+	if t.Select != nil {
+		query = s.selectQuery(t, extras)
+		written = t.Select.From + t.Select.Rest
+	} else {
+		query = s.tableReferences(query, false)
+	}
+	if syntax := OracleOnlySyntax(written); len(syntax) > 0 {
+		extras.note("query of table %s uses oracle only syntax, check it before running: %s", t.Name, strings.Join(syntax, ", "))
+	}
+
+	if s.TablePhysical(t, extras) != "" {
+		extras.note("physical options of table %s can't be applied to SELECT ... INTO", t.Name)
+	}
+
+	into := "INTO " + s.QuoteName(t.Name)
+	if len(t.SelectColumns) > 0 {
+		if topLevelKeyword(query, "ORDER") >= 0 {
+			extras.note("query of table %s is ordered, sql server doesn't allow ORDER BY in the derived table renaming its columns", t.Name)
+		}
+		if strings.HasPrefix(strings.ToUpper(query), "WITH") {
+			extras.note("query of table %s starts with WITH, sql server doesn't allow it in the derived table renaming its columns", t.Name)
+		}
+		return fmt.Sprintf("SELECT * %s FROM (\n%s\n) AS src (%s);", into, query, quoteList(t.SelectColumns)), nil
+	}
+
+	// INTO goes right before the first FROM of the outermost query, or at the end when it has none
+	if i := topLevelKeyword(query, "FROM"); i >= 0 {
+		return query[:i] + into + "\n" + query[i:] + ";", nil
+	}
+	return query + "\n" + into + ";", nil
+}
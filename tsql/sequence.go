@@ -0,0 +1,170 @@
+package tsql
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+var (
+	minBigint = big.NewInt(math.MinInt64)
+	maxBigint = big.NewInt(math.MaxInt64)
+)
+
+/* Clamps a number to the BIGINT range
+ * reports whether the value had to be changed
+ */
+func clampBigint(value string) (string, bool) {
+	n, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return value, false
+	}
+	if n.Cmp(maxBigint) > 0 {
+		return maxBigint.String(), true
+	}
+	if n.Cmp(minBigint) < 0 {
+		return minBigint.String(), true
+	}
+	return n.String(), false
+}
+
+/* Oracle keywords that change nothing in sql server */
+var ignoredSequenceKeywords = []string{"NOKEEP", "NOSCALE", "NOSHARD", "GLOBAL"}
+
+/* Resolves start, increment and bounds of a sequence the way oracle does and clamps them to BIGINT
+ * clamped bounds can't be reached anyway, only a clamped start or increment changes behaviour
+ */
+func sequenceValues(opts *generic.SequenceOptions, subject string, extras *tableExtras) (string, string, string, string) {
+	desc := opts.Descending()
+	minValue := opts.MinValue
+	if minValue == "" {
+		minValue = "1"
+		if desc {
+			minValue = "-999999999999999999999999999"
+		}
+	}
+	maxValue := opts.MaxValue
+	if maxValue == "" {
+		maxValue = "9999999999999999999999999999"
+		if desc {
+			maxValue = "-1"
+		}
+	}
+	start := opts.StartWith
+	if start == "" {
+		start = minValue
+		if desc {
+			start = maxValue
+		}
+	}
+	inc := opts.IncrementBy
+	if inc == "" {
+		inc = "1"
+	}
+
+	declared := start
+	start, clamped := clampBigint(start)
+	switch {
+	case clamped && opts.StartWith == "":
+		// oracle starts at the bound the sequence counts away from
+		bound := "MINVALUE"
+		if desc {
+			bound = "MAXVALUE"
+		}
+		extras.note("START WITH %s of %s, taken from its %s, is out of the BIGINT range, clamped to %s", declared, subject, bound, start)
+	case clamped:
+		extras.note("START WITH %s of %s is out of the BIGINT range, clamped to %s", declared, subject, start)
+	}
+	inc, clamped = clampBigint(inc)
+	if clamped {
+		extras.note("INCREMENT BY %s of %s is out of the BIGINT range, clamped to %s", opts.IncrementBy, subject, inc)
+	}
+	minValue, _ = clampBigint(minValue)
+	maxValue, _ = clampBigint(maxValue)
+	return start, inc, minValue, maxValue
+}
+
+/* Converts a sequence to CREATE SEQUENCE ... AS BIGINT
+ * oracle defaults are written out since sql server starts a BIGINT sequence at its minimum value
+ */
+func (s *Serializer) Sequence(seq *generic.SequenceDef) (string, error) {
+	opts := seq.Options
+	extras := &tableExtras{at: seq.Position}
+	start, inc, minValue, maxValue := sequenceValues(&opts, "sequence "+seq.Name.String(), extras)
+
+	for _, keyword := range opts.Extra {
+		if !slices.Contains(ignoredSequenceKeywords, keyword) {
+			extras.note("%s of sequence %s has no sql server equivalent and was left out", keyword, seq.Name)
+		}
+	}
+
+	lines := []string{
+		"START WITH " + start,
+		"INCREMENT BY " + inc,
+		"MINVALUE " + minValue,
+		"MAXVALUE " + maxValue,
+	}
+	if opts.Cache != "" {
+		lines = append(lines, "CACHE "+opts.Cache)
+	} else if opts.NoCache {
+		lines = append(lines, "NO CACHE")
+	}
+	if opts.Cycle {
+		lines = append(lines, "CYCLE")
+	} else {
+		lines = append(lines, "NO CYCLE")
+	}
+
+	var sb strings.Builder
+	s.writeNotes(&sb, "sequence "+seq.Name.String(), extras.notes)
+	fmt.Fprintf(&sb, "CREATE SEQUENCE %s AS BIGINT\n", s.QuoteName(seq.Name))
+	sb.WriteString(s.Indent + strings.Join(lines, "\n"+s.Indent) + ";\n")
+	s.writeBatchEnd(&sb)
+	return sb.String(), nil
+}
+
+/* Converts the generation clause of an identity column
+ * GENERATED ALWAYS maps to IDENTITY(seed, increment), anything IDENTITY can't express gets a sequence backed default
+ */
+func (s *Serializer) identity(t *generic.TableDef, c *generic.ColumnDef, extras *tableExtras) (string, error) {
+	ident := c.Identity
+	subject := "identity column " + c.Name
+	reasons := []string{}
+	if ident.Kind != generic.IDENTITY_ALWAYS {
+		reasons = append(reasons, "GENERATED "+ident.Kind+" allows explicit values")
+	}
+	if ident.Options.Cycle {
+		reasons = append(reasons, "it cycles")
+	}
+
+	if len(reasons) == 0 {
+		start, inc, minValue, maxValue := sequenceValues(&ident.Options, subject, extras)
+		if ident.Options.Descending() && minValue != minBigint.String() {
+			extras.note("MINVALUE %s of %s isn't enforced by IDENTITY", minValue, subject)
+		} else if !ident.Options.Descending() && maxValue != maxBigint.String() {
+			extras.note("MAXVALUE %s of %s isn't enforced by IDENTITY", maxValue, subject)
+		}
+		return fmt.Sprintf("IDENTITY(%s, %s)", start, inc), nil
+	}
+
+	seq := &generic.SequenceDef{
+		Name: generic.QualifiedName{
+			Schema: t.Name.Schema,
+			Object: generic.NamePart{Name: t.Name.Object.Normalized() + "_" + c.Name + "_SEQ", Quoted: true},
+		},
+		Options: ident.Options,
+	}
+	str, err := s.Sequence(seq)
+	if err != nil {
+		return "", err
+	}
+	extras.before = append(extras.before, str)
+	extras.note("%s uses sequence %s since %s", subject, seq.Name, strings.Join(reasons, " and "))
+	if ident.Kind == generic.IDENTITY_BY_DEFAULT_ON_NULL {
+		extras.note("inserting an explicit NULL into %s fails instead of taking the next value", subject)
+	}
+	return "DEFAULT NEXT VALUE FOR " + s.QuoteName(seq.Name), nil
+}
@@ -0,0 +1,514 @@
+package tsql
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+type Serializer struct {
+	// schema used when a table name has no schema part
+	DefaultSchema string
+	// renames source schemas, e.g. HR -> dbo, keys are upper case and names are upper cased to look them up
+	SchemaMap map[string]string
+	// renames grantees, e.g. APP_RW -> app_rw_role, keys are upper case like SchemaMap
+	PrincipalMap map[string]string
+	// tablespace -> filegroup, keys are upper case like SchemaMap
+	Filegroups map[string]string
+	// how temporary tables are converted, one of the TEMP_ constants
+	TempTables string
+	// writes GO after each statement when true
+	BatchSeparator bool
+	Indent         string
+	// rules used to map column types, see TypeMapFile
+	Types *TypeMap
+	// everything that couldn't be converted as declared, also written as comments in the output
+	// errors are about what was left out, warnings about what was converted differently
+	// the diagnostics name the script of the statement they are about, the caller fills in the converted one for the rest
+	Diagnostics generic.Diagnostics
+}
+
+func NewSerializer() *Serializer {
+	result := &Serializer{
+		DefaultSchema:  "dbo",
+		BatchSeparator: true,
+		Indent:         "    ",
+		Types:          DefaultTypeMap(),
+		TempTables:     TEMP_SESSION,
+	}
+	return result
+}
+
+/* Quotes a single identifier with brackets
+ * closing brackets are escaped by doubling them
+ */
+func QuoteIdentifier(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
+
+/* Returns the sql server schema of a name
+ * names without a schema get DefaultSchema, schemas in SchemaMap are renamed
+ */
+func (s *Serializer) Schema(name generic.QualifiedName) string {
+	if name.Schema.Name == "" {
+		return s.DefaultSchema
+	}
+	schema := name.Schema.Normalized()
+	if to, ok := s.SchemaMap[strings.ToUpper(schema)]; ok {
+		return to
+	}
+	return schema
+}
+
+/* Quotes a name as [schema].[object]
+ * the schema is left out when it resolves to empty and for #temporary tables, which live in tempdb
+ */
+func (s *Serializer) QuoteName(name generic.QualifiedName) string {
+	parts := []string{}
+	if schema := s.Schema(name); schema != "" && !strings.HasPrefix(name.Object.Name, "#") {
+		parts = append(parts, QuoteIdentifier(schema))
+	}
+	parts = append(parts, QuoteIdentifier(name.Object.Normalized()))
+	return strings.Join(parts, ".")
+}
+
+/* Statements and comments produced alongside a CREATE TABLE */
+type tableExtras struct {
+	// whole batches written before the statement, e.g. sequences used by defaults
+	before []string
+	// comment lines written before the statement
+	notes []tableNote
+	// statements written after the statement
+	after []string
+	// foreign keys are added once every table exists
+	foreignKeys []string
+	// what was left out of the statement, written as comments before it
+	leftOut []string
+	// where notes added now point to, the statement or column being converted
+	at generic.Position
+}
+
+type tableNote struct {
+	text string
+	at   generic.Position
+}
+
+/* Adds a note once, the same construct used twice is only reported once */
+func (e *tableExtras) note(format string, a ...any) {
+	text := fmt.Sprintf(format, a...)
+	for _, n := range e.notes {
+		if n.text == text {
+			return
+		}
+	}
+	e.notes = append(e.notes, tableNote{text: text, at: e.at})
+}
+
+/* Reports err and leaves out what it is about, the rest of the statement is still converted */
+func (s *Serializer) leaveOut(err error, extras *tableExtras) {
+	s.fail(err)
+	extras.leftOut = append(extras.leftOut, err.Error())
+}
+
+/* Reports err for something that couldn't be converted at all */
+func (s *Serializer) fail(err error) {
+	s.Diagnostics = append(s.Diagnostics, generic.ErrorDiagnostics(generic.SEVERITY_ERROR, "", err)...)
+}
+
+/* Points notes at p until the returned func is called, a zero p keeps the current position
+ *
+ *	defer extras.within(c.Position)()
+ */
+func (e *tableExtras) within(p generic.Position) func() {
+	outer := e.at
+	if !p.IsZero() {
+		e.at = p
+	}
+	return func() { e.at = outer }
+}
+
+/* Generates a name for constraints that have to be referred to later
+ * oracle lets sql server pick names for unnamed constraints otherwise
+ */
+func constraintName(t *generic.TableDef, con *generic.ConstraintDef) string {
+	if con.Name != "" {
+		return con.Name
+	}
+	prefix := map[string]string{
+		generic.CONSTRAINT_PRIMARY_KEY: "PK",
+		generic.CONSTRAINT_UNIQUE:      "UQ",
+		generic.CONSTRAINT_FOREIGN_KEY: "FK",
+		generic.CONSTRAINT_CHECK:       "CK",
+	}[con.Kind]
+	return strings.Join(append([]string{prefix, t.Name.Object.Normalized()}, con.Columns...), "_")
+}
+
+/* Converts the body of a constraint, without the CONSTRAINT name prefix */
+func (s *Serializer) ConstraintBody(t *generic.TableDef, con *generic.ConstraintDef, inline bool, extras *tableExtras) (string, error) {
+	cols := ""
+	if !inline {
+		cols = " (" + quoteList(con.Columns) + ")"
+	}
+	switch con.Kind {
+	case generic.CONSTRAINT_PRIMARY_KEY:
+		return "PRIMARY KEY" + cols, nil
+	case generic.CONSTRAINT_UNIQUE:
+		return "UNIQUE" + cols, nil
+	case generic.CONSTRAINT_CHECK:
+		cond, _ := s.Expression(t, con.Check, "check constraint "+constraintName(t, con), extras)
+		return fmt.Sprintf("CHECK (%s)", cond), nil
+	case generic.CONSTRAINT_FOREIGN_KEY:
+		result := "FOREIGN KEY" + cols + " REFERENCES " + s.QuoteName(con.RefTable)
+		if len(con.RefColumns) > 0 {
+			result += " (" + quoteList(con.RefColumns) + ")"
+		}
+		if con.DeleteRule != "" {
+			result += " ON DELETE " + con.DeleteRule
+		}
+		return result, nil
+	}
+	return "", fmt.Errorf("unsupported constraint kind %q", con.Kind)
+}
+
+/* Converts a constraint for use inside CREATE TABLE
+ * returns an empty string when the constraint can't be created as declared, extras explains why
+ */
+func (s *Serializer) Constraint(t *generic.TableDef, con *generic.ConstraintDef, inline bool, extras *tableExtras) (string, error) {
+	defer extras.within(con.Position)()
+	if con.Kind == generic.CONSTRAINT_FOREIGN_KEY {
+		if err := s.foreignKey(t, con, extras); err != nil {
+			return "", con.Position.Errorf(err, "error while converting constraint %s", constraintName(t, con))
+		}
+		return "", nil
+	}
+	body, err := s.ConstraintBody(t, con, inline, extras)
+	if err != nil {
+		return "", con.Position.Errorf(err, "error while converting constraint %s", constraintName(t, con))
+	}
+	name := con.Name
+	if con.State.Disabled {
+		switch con.Kind {
+		case generic.CONSTRAINT_CHECK:
+			// sql server can only disable these after creating them
+			name = constraintName(t, con)
+			extras.after = append(extras.after, fmt.Sprintf("ALTER TABLE %s NOCHECK CONSTRAINT %s;", s.QuoteName(t.Name), QuoteIdentifier(name)))
+		default:
+			extras.note("disabled %s constraint %s was left out: %s", con.Kind, constraintName(t, con), body)
+			return "", nil
+		}
+	}
+	if con.State.Deferrable {
+		extras.note("constraint %s is deferrable in oracle, sql server checks it immediately", constraintName(t, con))
+	}
+	// an index organized table is stored in its primary key like a clustered index
+	if con.Kind == generic.CONSTRAINT_PRIMARY_KEY && t.Physical != nil && t.Physical.Organization == "INDEX" {
+		body = strings.Replace(body, "PRIMARY KEY", "PRIMARY KEY CLUSTERED", 1)
+	}
+	// memory optimized tables have no clustered indexes
+	if con.Kind == generic.CONSTRAINT_PRIMARY_KEY && s.memoryOptimized(t) {
+		body = strings.Replace(body, "PRIMARY KEY", "PRIMARY KEY NONCLUSTERED", 1)
+	}
+	// the index behind a key goes to the filegroup of its USING INDEX TABLESPACE
+	if using := con.State.UsingIndex; using != nil && !s.memoryOptimized(t) {
+		if fg := s.Filegroup(using.Tablespace, "index of constraint "+constraintName(t, con), extras); fg != "" {
+			body += " ON " + QuoteIdentifier(fg)
+		}
+	}
+	if name != "" {
+		return "CONSTRAINT " + QuoteIdentifier(name) + " " + body, nil
+	}
+	return body, nil
+}
+
+/* Queues an ALTER TABLE for a foreign key
+ * NOVALIDATE maps to WITH NOCHECK, which also skips checking the existing rows
+ */
+func (s *Serializer) foreignKey(t *generic.TableDef, con *generic.ConstraintDef, extras *tableExtras) error {
+	body, err := s.ConstraintBody(t, con, false, extras)
+	if err != nil {
+		return err
+	}
+	table := s.QuoteName(t.Name)
+	name := con.Name
+	if con.State.Disabled {
+		name = constraintName(t, con)
+	}
+	if name != "" {
+		body = "CONSTRAINT " + QuoteIdentifier(name) + " " + body
+	}
+	check := ""
+	if con.State.NoValidate {
+		check = " WITH NOCHECK"
+	}
+	extras.foreignKeys = append(extras.foreignKeys, fmt.Sprintf("ALTER TABLE %s%s ADD %s;", table, check, body))
+	if con.State.Disabled {
+		extras.foreignKeys = append(extras.foreignKeys, fmt.Sprintf("ALTER TABLE %s NOCHECK CONSTRAINT %s;", table, QuoteIdentifier(name)))
+	}
+	if con.State.Deferrable {
+		extras.note("constraint %s is deferrable in oracle, sql server checks it immediately", constraintName(t, con))
+	}
+	return nil
+}
+
+func quoteList(names []string) string {
+	results := []string{}
+	for _, name := range names {
+		results = append(results, QuoteIdentifier(name))
+	}
+	return strings.Join(results, ", ")
+}
+
+func (s *Serializer) Column(t *generic.TableDef, c *generic.ColumnDef, extras *tableExtras) (string, error) {
+	defer extras.within(c.Position)()
+	if c.Virtual != nil {
+		return s.computedColumn(t, c, extras)
+	}
+	_type, err := s.Types.Map(c)
+	if err != nil {
+		return "", c.Position.Errorf(err, "error while converting column %s", c.Name)
+	}
+	if note := s.Types.Note(c); note != "" {
+		extras.note("column %s is %s, %s", c.Name, c.Type, note)
+	}
+	if c.Type.Scale < 0 {
+		extras.note("column %s is %s, sql server has no negative scale so values aren't rounded to %d places left of the decimal point", c.Name, c.Type, -c.Type.Scale)
+	}
+	// NUMBER without precision maps to FLOAT which can't be an identity
+	if c.Identity != nil && c.Type.Name == "NUMBER" && c.Type.Precision == 0 {
+		_type = "BIGINT"
+	}
+	result := QuoteIdentifier(c.Name) + " " + _type
+	if c.Identity != nil {
+		ident, err := s.identity(t, c, extras)
+		if err != nil {
+			return "", c.Position.Errorf(err, "error while converting column %s", c.Name)
+		}
+		result += " " + ident
+	} else if c.Default != nil {
+		result += " DEFAULT " + s.defaultExpression(t, c, _type, extras)
+	}
+	// primary key and identity columns are implicitly NOT NULL in oracle, sql server wants it spelled out
+	pk := t.PrimaryKey()
+	if c.NotNull || c.Identity != nil || (pk != nil && slices.Contains(pk.Columns, c.Name)) {
+		result += " NOT NULL"
+	} else {
+		result += " NULL"
+	}
+	for _, con := range c.Constraints {
+		if con.Kind == generic.CONSTRAINT_NOT_NULL || con.Kind == generic.CONSTRAINT_NULL {
+			continue
+		}
+		str, err := s.Constraint(t, con, true, extras)
+		if err != nil {
+			return "", c.Position.Errorf(err, "error while converting column %s", c.Name)
+		}
+		if str != "" {
+			result += " " + str
+		}
+	}
+	return result, nil
+}
+
+/* Converts a virtual column to a computed column
+ * deterministic expressions are PERSISTED, a declared type is kept with a CAST
+ */
+func (s *Serializer) computedColumn(t *generic.TableDef, c *generic.ColumnDef, extras *tableExtras) (string, error) {
+	expr, deterministic := s.computedExpression(t, c.Virtual, c.Name, "virtual column "+c.Name, extras)
+	if c.Type.Name != "" {
+		_type, err := s.Types.Map(c)
+		if err != nil {
+			return "", c.Position.Errorf(err, "error while converting column %s", c.Name)
+		}
+		expr = "CAST(" + expr + " AS " + _type + ")"
+	}
+	result := QuoteIdentifier(c.Name) + " AS (" + expr + ")"
+	if deterministic {
+		result += " PERSISTED"
+		if c.NotNull {
+			result += " NOT NULL"
+		}
+	} else {
+		extras.note("virtual column %s isn't deterministic in sql server, it is computed on every read", c.Name)
+		if c.NotNull {
+			extras.note("virtual column %s is NOT NULL in oracle, sql server only allows that on persisted columns", c.Name)
+		}
+	}
+	for _, con := range c.Constraints {
+		if con.Kind == generic.CONSTRAINT_NOT_NULL || con.Kind == generic.CONSTRAINT_NULL {
+			continue
+		}
+		str, err := s.Constraint(t, con, true, extras)
+		if err != nil {
+			return "", c.Position.Errorf(err, "error while converting column %s", c.Name)
+		}
+		if str != "" {
+			result += " " + str
+		}
+	}
+	return result, nil
+}
+
+/* Converts a table to its CREATE TABLE statement, or SELECT ... INTO for CREATE TABLE ... AS SELECT
+ * foreign keys are returned separately so they can be added after every referenced table exists
+ */
+func (s *Serializer) table(t *generic.TableDef) (string, []string, error) {
+	extras := &tableExtras{at: t.Position}
+	subject := "table " + t.Name.String()
+	var stmt string
+	var err error
+	if t.Temporary != "" {
+		t, err = s.temporaryTable(t, extras)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	if t.SelectStatement != "" {
+		stmt, err = s.SelectInto(t, extras)
+	} else {
+		stmt, err = s.createTable(t, extras)
+	}
+	if err != nil {
+		return "", nil, t.Position.Errorf(err, "error while converting table %s", t.Name)
+	}
+	for _, idx := range t.Indexes {
+		stmts, err := s.Index(t, idx, extras)
+		if err != nil {
+			s.leaveOut(t.Position.Errorf(err, "error while converting table %s", t.Name), extras)
+			continue
+		}
+		extras.after = append(extras.after, stmts...)
+	}
+	extras.after = append(extras.after, s.Comments(t)...)
+
+	var sb strings.Builder
+	for _, block := range extras.before {
+		sb.WriteString(block + "\n")
+	}
+	s.writeLeftOut(&sb, extras.leftOut)
+	s.writeNotes(&sb, subject, extras.notes)
+	sb.WriteString(stmt + "\n")
+	for _, stmt := range extras.after {
+		sb.WriteString(stmt + "\n")
+	}
+	s.writeBatchEnd(&sb)
+	return sb.String(), extras.foreignKeys, nil
+}
+
+func (s *Serializer) createTable(t *generic.TableDef, extras *tableExtras) (string, error) {
+	lines := []string{}
+	for _, c := range t.Columns {
+		line, err := s.Column(t, c, extras)
+		if err != nil {
+			s.leaveOut(t.Position.Errorf(err, "error while converting table %s", t.Name), extras)
+			continue
+		}
+		lines = append(lines, s.Indent+line)
+	}
+	if len(lines) == 0 {
+		return "", fmt.Errorf("none of the columns could be converted")
+	}
+	for _, con := range t.Constraints {
+		line, err := s.Constraint(t, con, false, extras)
+		if err != nil {
+			s.leaveOut(t.Position.Errorf(err, "error while converting table %s", t.Name), extras)
+			continue
+		}
+		if line != "" {
+			lines = append(lines, s.Indent+line)
+		}
+	}
+	// memory optimized tables need at least one index
+	if s.memoryOptimized(t) && t.PrimaryKey() == nil {
+		index := QuoteIdentifier("IX_" + t.Name.Object.Normalized() + "_" + SESSION_COLUMN)
+		lines = append(lines, fmt.Sprintf("%sINDEX %s NONCLUSTERED (%s)", s.Indent, index, QuoteIdentifier(SESSION_COLUMN)))
+	}
+	return fmt.Sprintf("CREATE TABLE %s (\n%s\n)%s;", s.QuoteName(t.Name), strings.Join(lines, ",\n"), s.TablePhysical(t, extras)), nil
+}
+
+func (s *Serializer) Table(t *generic.TableDef) (string, error) {
+	result, foreignKeys, err := s.table(t)
+	if err != nil {
+		return "", err
+	}
+	return result + s.statements(foreignKeys), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/* Serializes every sequence and table ordered by name so output is stable between runs
+ * sequences come first so column defaults can use them
+ * foreign keys come after the tables so tables can reference each other in any order, grants come last
+ * what can't be converted is left out and reported as an error in Diagnostics, the rest is still converted
+ */
+func (s *Serializer) Tables(d *generic.TablesDef) string {
+	blocks := []string{}
+	for _, name := range sortedKeys(d.Sequences) {
+		str, err := s.Sequence(d.Sequences[name])
+		if err != nil {
+			s.fail(err)
+			continue
+		}
+		blocks = append(blocks, str)
+	}
+
+	foreignKeys := []string{}
+	for _, name := range sortedKeys(d.Tables) {
+		str, fks, err := s.table(d.Tables[name])
+		if err != nil {
+			s.fail(err)
+			continue
+		}
+		blocks = append(blocks, str)
+		foreignKeys = append(foreignKeys, fks...)
+	}
+	if len(foreignKeys) > 0 {
+		blocks = append(blocks, s.statements(foreignKeys))
+	}
+	if grants := s.grants(d); grants != "" {
+		blocks = append(blocks, grants)
+	}
+	return strings.Join(blocks, "\n")
+}
+
+/* Joins statements into a single batch */
+func (s *Serializer) statements(stmts []string) string {
+	if len(stmts) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	for _, stmt := range stmts {
+		sb.WriteString(stmt + "\n")
+	}
+	s.writeBatchEnd(&sb)
+	return sb.String()
+}
+
+/* Writes what was left out as comments, it was reported when it was left out */
+func (s *Serializer) writeLeftOut(sb *strings.Builder, leftOut []string) {
+	for _, message := range leftOut {
+		fmt.Fprintf(sb, "-- left out, %s\n", strings.ReplaceAll(message, "\n", " "))
+	}
+}
+
+/* Writes notes as comments and keeps them as warnings about subject at where they were noted */
+func (s *Serializer) writeNotes(sb *strings.Builder, subject string, notes []tableNote) {
+	for _, note := range notes {
+		fmt.Fprintf(sb, "-- %s\n", note.text)
+		s.Diagnostics = append(s.Diagnostics, &generic.Diagnostic{Severity: generic.SEVERITY_WARNING, File: note.at.File, Line: note.at.Line, Column: note.at.Column, Message: subject + ": " + note.text})
+	}
+}
+
+func (s *Serializer) writeBatchEnd(sb *strings.Builder) {
+	if s.BatchSeparator {
+		sb.WriteString("GO\n")
+	}
+}
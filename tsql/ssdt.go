@@ -0,0 +1,123 @@
+package tsql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* Folders of an SSDT project, see Serializer.Objects */
+const OBJECT_TABLES string = "Tables"
+const OBJECT_SEQUENCES string = "Sequences"
+
+// schemas and permissions
+const OBJECT_SECURITY string = "Security"
+
+// tables a project can't declare, run from the post-deployment script: SELECT ... INTO and ##tables
+const OBJECT_POST_DEPLOYMENT string = "Scripts/PostDeployment"
+
+/* The script of a single object, written to its own file in an SSDT project */
+type ObjectScript struct {
+	// one of the OBJECT_ constants
+	Kind string
+	// sql server schema, empty for schemas themselves and ##tables
+	Schema string
+	Name   string
+	Script string
+}
+
+// characters windows doesn't allow in file names
+var fileNameReplacer = strings.NewReplacer("/", "_", "\\", "_", ":", "_", "*", "_", "?", "_", "\"", "_", "<", "_", ">", "_", "|", "_")
+
+/* Slash separated path of the object's file, e.g. Tables/dbo.EMP.sql */
+func (o *ObjectScript) Path() string {
+	name := o.Name
+	if o.Schema != "" {
+		name = o.Schema + "." + name
+	}
+	return o.Kind + "/" + fileNameReplacer.Replace(name) + ".sql"
+}
+
+/* Converts the definitions to one script per object, laid out like an SSDT project
+ * every table file holds what was converted alongside the table: sequences of identity columns, partition functions,
+ * indexes, comments and the table's foreign keys. SSDT resolves the order they have to be created in.
+ * schemas other than dbo get a CREATE SCHEMA file and grants are grouped by the object they're on
+ * tables created from a query and global temporary tables can't be built by SSDT, they go to OBJECT_POST_DEPLOYMENT
+ * like Tables what can't be converted is left out and reported in Diagnostics
+ */
+func (s *Serializer) Objects(d *generic.TablesDef) []*ObjectScript {
+	results := []*ObjectScript{}
+	schemas := []string{}
+	addSchema := func(schema string) {
+		if schema != "" && schema != "dbo" && !slices.Contains(schemas, schema) {
+			schemas = append(schemas, schema)
+		}
+	}
+
+	for _, name := range sortedKeys(d.Sequences) {
+		seq := d.Sequences[name]
+		str, err := s.Sequence(seq)
+		if err != nil {
+			s.fail(err)
+			continue
+		}
+		schema := s.Schema(seq.Name)
+		addSchema(schema)
+		results = append(results, &ObjectScript{Kind: OBJECT_SEQUENCES, Schema: schema, Name: seq.Name.Object.Normalized(), Script: str})
+	}
+
+	for _, name := range sortedKeys(d.Tables) {
+		t := d.Tables[name]
+		str, err := s.Table(t)
+		if err != nil {
+			s.fail(err)
+			continue
+		}
+		object := &ObjectScript{Kind: OBJECT_TABLES, Schema: s.Schema(t.Name), Name: t.Name.Object.Normalized(), Script: str}
+		global := t.Temporary != "" && s.TempTables == TEMP_GLOBAL
+		if global {
+			object.Schema = ""
+			object.Name = globalTempName(t).Object.Name
+		}
+		addSchema(object.Schema)
+		if global || t.SelectStatement != "" {
+			object.Kind = OBJECT_POST_DEPLOYMENT
+			reason := "is created by SELECT ... INTO"
+			if global {
+				reason = "is a global temporary table"
+			}
+			message := fmt.Sprintf("table %s %s which an SSDT project can't build, it was written to %s to run from the post-deployment script", t.Name, reason, object.Path())
+			s.Diagnostics = append(s.Diagnostics, &generic.Diagnostic{Severity: generic.SEVERITY_WARNING, File: t.Position.File, Line: t.Position.Line, Column: t.Position.Column, Message: message})
+		}
+		results = append(results, object)
+	}
+
+	// grants in script order, grouped by the object they're on
+	on := []string{}
+	grants := map[string][]*generic.Grant{}
+	for _, g := range d.Grants {
+		key := g.Where.String()
+		if _, ok := grants[key]; !ok {
+			on = append(on, key)
+		}
+		grants[key] = append(grants[key], g)
+	}
+	for _, key := range on {
+		str := s.grantBatch(d, grants[key])
+		if str == "" {
+			continue
+		}
+		where := grants[key][0].Where
+		results = append(results, &ObjectScript{Kind: OBJECT_SECURITY, Schema: s.Schema(where), Name: where.Object.Normalized() + ".Permissions", Script: str})
+	}
+
+	slices.Sort(schemas)
+	for _, schema := range schemas {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "CREATE SCHEMA %s;\n", QuoteIdentifier(schema))
+		s.writeBatchEnd(&sb)
+		results = append(results, &ObjectScript{Kind: OBJECT_SECURITY, Name: schema, Script: sb.String()})
+	}
+	return results
+}
@@ -0,0 +1,143 @@
+package tsql
+
+import (
+	"fmt"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* Ways to convert oracle temporary tables, see Serializer.TempTables
+ * oracle keeps the definition of a temporary table and gives every session its own rows,
+ * sql server has no single equivalent
+ */
+// ##TABLE, dropped with the session that created it and shared by every session
+const TEMP_GLOBAL string = "global"
+
+// permanent table whose rows are keyed by the session that inserted them
+const TEMP_SESSION string = "session"
+
+// memory optimized SCHEMA_ONLY table keyed by session, rows never touch the disk
+const TEMP_MEMORY string = "memory"
+
+var TempTableModes = []string{TEMP_GLOBAL, TEMP_SESSION, TEMP_MEMORY}
+
+// column added to tables converted with TEMP_SESSION or TEMP_MEMORY
+const SESSION_COLUMN string = "SESSION_ID"
+
+// default of the session column written the oracle way, translates to @@SPID
+var sessionDefault = &generic.Expression{
+	Text: "SYS_CONTEXT('USERENV', 'SID')",
+	Tree: &generic.FunctionExpr{
+		Name: generic.NewQualifiedName(generic.NamePart{Name: "SYS_CONTEXT"}),
+		Args: []generic.Expr{
+			&generic.LiteralExpr{Kind: generic.LITERAL_STRING, Value: "USERENV"},
+			&generic.LiteralExpr{Kind: generic.LITERAL_STRING, Value: "SID"},
+		},
+	},
+}
+
+/* Returns true when t is created as a memory optimized table */
+func (s *Serializer) memoryOptimized(t *generic.TableDef) bool {
+	return t.Temporary != "" && s.TempTables == TEMP_MEMORY
+}
+
+/* Rewrites a temporary table for the configured TempTables mode
+ * returns a copy, the parsed definition is left untouched
+ */
+func (s *Serializer) temporaryTable(t *generic.TableDef, extras *tableExtras) (*generic.TableDef, error) {
+	name := t.Name.String()
+	onCommit := t.OnCommit
+	if onCommit == "" {
+		onCommit = "DELETE ROWS"
+	}
+	if t.Temporary == "PRIVATE" {
+		end := "session"
+		if onCommit == "DELETE ROWS" {
+			end = "transaction"
+		}
+		extras.note("private temporary table %s is dropped at the end of the %s in oracle, it is created like a global one", name, end)
+	}
+
+	result := *t
+	switch s.TempTables {
+	case TEMP_GLOBAL:
+		result.Name = globalTempName(t)
+		extras.note("temporary table %s becomes %s, it is dropped when the session that created it ends and its rows are shared by every session", name, result.Name.Object.Name)
+		if onCommit == "DELETE ROWS" {
+			extras.note("temporary table %s deletes its rows on commit in oracle, sql server keeps them", name)
+		}
+		if t.Physical != nil {
+			extras.note("physical clauses of temporary table %s were left out, ## tables live in tempdb", name)
+			result.Physical = nil
+		}
+		if t.Comment != "" {
+			extras.note("comment on temporary table %s was left out, ## tables can't have extended properties", name)
+		}
+		return &result, nil
+	case TEMP_SESSION, TEMP_MEMORY:
+		if t.Columns.Get(SESSION_COLUMN) != nil {
+			return nil, fmt.Errorf("temporary table %s already has a %s column", name, SESSION_COLUMN)
+		}
+		if t.SelectStatement != "" {
+			extras.note("temporary table %s is created from a query, %s has to be added by hand", name, SESSION_COLUMN)
+			return &result, nil
+		}
+		sessionKey(&result)
+		extras.note("temporary table %s is shared by every session, queries have to filter on %s = @@SPID and delete the session's rows when it ends", name, SESSION_COLUMN)
+		if onCommit == "DELETE ROWS" {
+			extras.note("temporary table %s deletes its rows on commit in oracle, delete the session's rows at commit instead", name)
+		}
+		if s.TempTables == TEMP_MEMORY {
+			extras.note("temporary table %s is memory optimized, the database needs a MEMORY_OPTIMIZED_DATA filegroup", name)
+			if t.Physical != nil {
+				extras.note("physical clauses of temporary table %s were left out, memory optimized tables don't use them", name)
+				result.Physical = nil
+			}
+		}
+		return &result, nil
+	}
+	return nil, fmt.Errorf("unsupported temporary table mode %q, expected one of %s", s.TempTables, strings.Join(TempTableModes, ", "))
+}
+
+/* Name of t as a ##table, it has no schema since it lives in tempdb */
+func globalTempName(t *generic.TableDef) generic.QualifiedName {
+	return generic.QualifiedName{Object: generic.NamePart{Name: "##" + t.Name.Object.Normalized(), Quoted: true}}
+}
+
+/* Adds the session column to t and makes it the first column of every primary key and unique constraint
+ * inline keys are moved out of line since they now cover two columns
+ */
+func sessionKey(t *generic.TableDef) {
+	keyed := func(con *generic.ConstraintDef) *generic.ConstraintDef {
+		result := *con
+		result.Columns = append([]string{SESSION_COLUMN}, con.Columns...)
+		return &result
+	}
+	isKey := func(con *generic.ConstraintDef) bool {
+		return con.Kind == generic.CONSTRAINT_PRIMARY_KEY || con.Kind == generic.CONSTRAINT_UNIQUE
+	}
+
+	columns := t.Columns
+	constraints := t.Constraints
+	t.Columns = generic.ColumnsDef{}
+	t.Constraints = nil
+	t.Columns.Add(&generic.ColumnDef{Name: SESSION_COLUMN, Type: generic.DataType{Name: "NUMBER", Precision: 5}, Default: sessionDefault, NotNull: true})
+	for _, c := range columns {
+		column := *c
+		column.Constraints = nil
+		for _, con := range c.Constraints {
+			if isKey(con) {
+				t.Constraints = append(t.Constraints, keyed(con))
+			} else {
+				column.Constraints = append(column.Constraints, con)
+			}
+		}
+		t.Columns.Add(&column)
+	}
+	for _, con := range constraints {
+		if isKey(con) {
+			con = keyed(con)
+		}
+		t.Constraints = append(t.Constraints, con)
+	}
+}
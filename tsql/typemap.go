@@ -0,0 +1,241 @@
+package tsql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"tsqlgrl/generic"
+)
+
+/* A single oracle -> sql server type mapping
+ * Type is matched against the full type name, e.g. TIMESTAMP WITH TIME ZONE
+ * optional bounds narrow which columns the rule applies to, a nil bound always matches
+ * a precision, scale or length of 0 means the source didn't specify one
+ *
+ * Target may contain the placeholders {length}, {precision}, {scale} and {fraction}
+ * Note is reported for every column the rule maps, for rules that lose part of the value, it takes the same placeholders
+ */
+type TypeRule struct {
+	Type         string `json:"type"`
+	Semantics    string `json:"semantics,omitempty"`
+	MinPrecision *int   `json:"minPrecision,omitempty"`
+	MaxPrecision *int   `json:"maxPrecision,omitempty"`
+	MinScale     *int   `json:"minScale,omitempty"`
+	MaxScale     *int   `json:"maxScale,omitempty"`
+	// true only matches types declared with a scale, false only ones without, e.g. NUMBER(*,0) has one and NUMBER doesn't
+	Scaled      *bool  `json:"scaled,omitempty"`
+	MinLength   *int   `json:"minLength,omitempty"`
+	MaxLength   *int   `json:"maxLength,omitempty"`
+	MinFraction *int   `json:"minFraction,omitempty"`
+	MaxFraction *int   `json:"maxFraction,omitempty"`
+	Target      string `json:"target"`
+	Note        string `json:"note,omitempty"`
+}
+
+/* Ordered list of rules, the first matching rule wins */
+type TypeMap struct {
+	Rules []TypeRule `json:"rules"`
+}
+
+/* Layout of a rules file
+ * rules are tried before the defaults unless Replace is set
+ */
+type TypeMapFile struct {
+	Replace bool       `json:"replace,omitempty"`
+	Rules   []TypeRule `json:"rules"`
+}
+
+func bound(n int) *int {
+	return &n
+}
+
+func flag(b bool) *bool {
+	return &b
+}
+
+func DefaultTypeMap() *TypeMap {
+	result := &TypeMap{
+		Rules: []TypeRule{
+			// NUMBER without precision or scale can hold anything, approximate it
+			// NUMBER(*,s) is exact with 38 digits and falls through to DECIMAL(38, s)
+			{Type: "NUMBER", MaxPrecision: bound(0), Scaled: flag(false), Target: "FLOAT(53)"},
+			{Type: "NUMBER", MinPrecision: bound(1), MaxPrecision: bound(9), MaxScale: bound(0), Target: "INT"},
+			{Type: "NUMBER", MinPrecision: bound(1), MaxPrecision: bound(18), MaxScale: bound(0), Target: "BIGINT"},
+			{Type: "NUMBER", MinPrecision: bound(1), MaxPrecision: bound(38), Target: "DECIMAL({precision}, {scale})"},
+			{Type: "NUMBER", Target: "DECIMAL(38, {scale})"},
+			{Type: "NUMERICAL", MaxPrecision: bound(0), Scaled: flag(false), Target: "FLOAT(53)"},
+			{Type: "NUMERICAL", MinPrecision: bound(1), MaxPrecision: bound(38), Target: "DECIMAL({precision}, {scale})"},
+			{Type: "NUMERICAL", Target: "DECIMAL(38, {scale})"},
+			{Type: "DECIMAL", MinPrecision: bound(1), MaxPrecision: bound(38), Target: "DECIMAL({precision}, {scale})"},
+			{Type: "DECIMAL", Target: "DECIMAL(38, {scale})"},
+			{Type: "NUMERIC", MinPrecision: bound(1), MaxPrecision: bound(38), Target: "DECIMAL({precision}, {scale})"},
+			{Type: "NUMERIC", Target: "DECIMAL(38, {scale})"},
+			{Type: "INT", Target: "INT"},
+			{Type: "INTEGER", Target: "INT"},
+			{Type: "SMALLINT", Target: "SMALLINT"},
+			// FLOAT precision is binary digits in both, sql server stops at 53
+			{Type: "FLOAT", MaxPrecision: bound(0), Target: "FLOAT(53)"},
+			{Type: "FLOAT", MaxPrecision: bound(53), Target: "FLOAT({precision})"},
+			{Type: "FLOAT", Target: "FLOAT(53)"},
+			{Type: "REAL", Target: "FLOAT(53)"},
+			{Type: "DOUBLE PRECISION", Target: "FLOAT(53)"},
+			{Type: "BINARY_FLOAT", Target: "REAL"},
+			{Type: "BINARY_DOUBLE", Target: "FLOAT(53)"},
+			{Type: "BOOLEAN", Target: "BIT"},
+
+			{Type: "VARCHAR2", Semantics: "CHAR", MinLength: bound(1), MaxLength: bound(4000), Target: "NVARCHAR({length})"},
+			{Type: "VARCHAR2", Semantics: "CHAR", Target: "NVARCHAR(MAX)"},
+			{Type: "VARCHAR2", MinLength: bound(1), MaxLength: bound(8000), Target: "VARCHAR({length})"},
+			{Type: "VARCHAR2", Target: "VARCHAR(MAX)"},
+			{Type: "VARCHAR", MinLength: bound(1), MaxLength: bound(8000), Target: "VARCHAR({length})"},
+			{Type: "VARCHAR", Target: "VARCHAR(MAX)"},
+			{Type: "CHAR", Semantics: "CHAR", MaxLength: bound(0), Target: "NCHAR(1)"},
+			{Type: "CHAR", Semantics: "CHAR", Target: "NCHAR({length})"},
+			{Type: "CHAR", MaxLength: bound(0), Target: "CHAR(1)"},
+			{Type: "CHAR", Target: "CHAR({length})"},
+			{Type: "NVARCHAR2", MinLength: bound(1), MaxLength: bound(4000), Target: "NVARCHAR({length})"},
+			{Type: "NVARCHAR2", Target: "NVARCHAR(MAX)"},
+			{Type: "NCHAR", MaxLength: bound(0), Target: "NCHAR(1)"},
+			{Type: "NCHAR", Target: "NCHAR({length})"},
+			{Type: "CLOB", Target: "NVARCHAR(MAX)"},
+			{Type: "NCLOB", Target: "NVARCHAR(MAX)"},
+			{Type: "LONG", Target: "VARCHAR(MAX)"},
+			{Type: "JSON", Target: "NVARCHAR(MAX)"},
+
+			{Type: "BLOB", Target: "VARBINARY(MAX)"},
+			{Type: "RAW", MinLength: bound(1), MaxLength: bound(8000), Target: "VARBINARY({length})"},
+			{Type: "RAW", Target: "VARBINARY(MAX)"},
+			{Type: "LONG RAW", Target: "VARBINARY(MAX)"},
+			// the file itself stays outside the database, only its contents can be copied over
+			{Type: "BFILE", Target: "VARBINARY(MAX)"},
+
+			{Type: "DATE", Target: "DATETIME2(0)"},
+			// sql server stops at 7 fractional digits
+			{Type: "TIMESTAMP", MaxFraction: bound(7), Target: "DATETIME2({fraction})"},
+			{Type: "TIMESTAMP", Target: "DATETIME2(7)", Note: fractionLost},
+			{Type: "TIMESTAMP WITH TIME ZONE", MaxFraction: bound(7), Target: "DATETIMEOFFSET({fraction})"},
+			{Type: "TIMESTAMP WITH TIME ZONE", Target: "DATETIMEOFFSET(7)", Note: fractionLost},
+			// values are stored in the database time zone
+			{Type: "TIMESTAMP WITH LOCAL TIME ZONE", MaxFraction: bound(7), Target: "DATETIME2({fraction})"},
+			{Type: "TIMESTAMP WITH LOCAL TIME ZONE", Target: "DATETIME2(7)", Note: fractionLost},
+			// no interval types in sql server, values are kept as oracle interval literals like +01-06
+			{Type: "INTERVAL YEAR TO MONTH", Target: "VARCHAR(30)"},
+			{Type: "INTERVAL DAY TO SECOND", Target: "VARCHAR(30)"},
+
+			{Type: "ROWID", Target: "CHAR(18)"},
+			{Type: "UROWID", Target: "VARCHAR(4000)"},
+			{Type: "SYS.XMLTYPE", Target: "XML"},
+			{Type: "XMLTYPE", Target: "XML"},
+		},
+	}
+	return result
+}
+
+/* Checks the rules and combines them with the defaults, source names where they were read from in errors */
+func (file TypeMapFile) TypeMap(source string) (*TypeMap, error) {
+	for i, rule := range file.Rules {
+		if rule.Type == "" || rule.Target == "" {
+			return nil, fmt.Errorf("type rule %d in %s needs both type and target", i, source)
+		}
+	}
+
+	result := DefaultTypeMap()
+	if file.Replace {
+		result.Rules = file.Rules
+	} else {
+		result.Rules = append(file.Rules, result.Rules...)
+	}
+	return result, nil
+}
+
+/* Normalizes an oracle type name for comparison
+ * "SYS"."XMLTYPE" becomes SYS.XMLTYPE and runs of spaces become one
+ */
+func NormalizeTypeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(strings.ReplaceAll(name, "\"", ""))), " ")
+}
+
+func inBounds(v int, lo *int, hi *int) bool {
+	if lo != nil && v < *lo {
+		return false
+	}
+	if hi != nil && v > *hi {
+		return false
+	}
+	return true
+}
+
+func (r *TypeRule) Matches(c *generic.ColumnDef) bool {
+	t := c.Type
+	if NormalizeTypeName(r.Type) != NormalizeTypeName(t.FullName()) {
+		return false
+	}
+	if r.Semantics != "" && !strings.EqualFold(r.Semantics, t.LengthSemantics) {
+		return false
+	}
+	if r.Scaled != nil && *r.Scaled != t.Scaled {
+		return false
+	}
+	return inBounds(t.Precision, r.MinPrecision, r.MaxPrecision) &&
+		inBounds(t.Scale, r.MinScale, r.MaxScale) &&
+		inBounds(t.Length, r.MinLength, r.MaxLength) &&
+		inBounds(t.FractionalSeconds, r.MinFraction, r.MaxFraction)
+}
+
+// note of the rules clamping fractional seconds
+const fractionLost string = "sql server keeps 7 of its {fraction} fractional digits of seconds, the rest is rounded off"
+
+/* Fills the target placeholders with the column's arguments */
+func (r *TypeRule) Render(c *generic.ColumnDef) string {
+	return fill(r.Target, c)
+}
+
+func fill(s string, c *generic.ColumnDef) string {
+	replacer := strings.NewReplacer(
+		"{length}", strconv.Itoa(c.Type.Length),
+		"{precision}", strconv.Itoa(c.Type.Precision),
+		// sql server has no negative scale, the rounding is lost either way
+		"{scale}", strconv.Itoa(max(c.Type.Scale, 0)),
+		"{fraction}", strconv.Itoa(c.Type.FractionalSeconds),
+	)
+	return replacer.Replace(s)
+}
+
+/* Maps an oracle column type to its sql server equivalent
+ * returns an error when no rule matches
+ */
+func (m *TypeMap) Map(c *generic.ColumnDef) (string, error) {
+	rule, c := m.match(c)
+	if rule != nil {
+		return rule.Render(c), nil
+	}
+	if c.Type.UserDefined {
+		return "", fmt.Errorf("no sql server mapping for object type %s, add a type rule for it", c.Type)
+	}
+	return "", fmt.Errorf("no sql server mapping for column type %s", c.Type)
+}
+
+/* The note of the rule mapping c, empty when the rule has none or nothing maps c */
+func (m *TypeMap) Note(c *generic.ColumnDef) string {
+	rule, c := m.match(c)
+	if rule == nil {
+		return ""
+	}
+	return fill(rule.Note, c)
+}
+
+/* The first rule matching c together with the column as the rules see it */
+func (m *TypeMap) match(c *generic.ColumnDef) (*TypeRule, *generic.ColumnDef) {
+	if c.Type.Scale < 0 && c.Type.Precision > 0 {
+		// NUMBER(p,-s) holds p+s digits before the decimal point
+		widened := *c
+		widened.Type.Precision -= c.Type.Scale
+		c = &widened
+	}
+	for i := range m.Rules {
+		if m.Rules[i].Matches(c) {
+			return &m.Rules[i], c
+		}
+	}
+	return nil, c
+}
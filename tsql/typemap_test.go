@@ -0,0 +1,115 @@
+package tsql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultTypeMap(t *testing.T) {
+	tests := []struct {
+		_type string
+		want  string
+		// part of the rule's note, empty when the rule has none
+		note string
+	}{
+		{`NUMBER`, `FLOAT(53)`, ""},
+		{`NUMBER(9)`, `INT`, ""},
+		{`NUMBER(10)`, `BIGINT`, ""},
+		{`NUMBER(18,0)`, `BIGINT`, ""},
+		{`NUMBER(19)`, `DECIMAL(19, 0)`, ""},
+		{`NUMBER(10,2)`, `DECIMAL(10, 2)`, ""},
+		{`NUMBER(*,0)`, `DECIMAL(38, 0)`, ""},
+		{`NUMBER(*,2)`, `DECIMAL(38, 2)`, ""},
+		// NUMBER(5,-2) holds 7 digits before the decimal point
+		{`NUMBER(5,-2)`, `INT`, ""},
+		{`NUMBER(10,-2)`, `BIGINT`, ""},
+		{`FLOAT`, `FLOAT(53)`, ""},
+		{`FLOAT(126)`, `FLOAT(53)`, ""},
+		{`FLOAT(24)`, `FLOAT(24)`, ""},
+		{`VARCHAR2(100)`, `VARCHAR(100)`, ""},
+		{`VARCHAR2(100 BYTE)`, `VARCHAR(100)`, ""},
+		{`VARCHAR2(100 CHAR)`, `NVARCHAR(100)`, ""},
+		{`VARCHAR2(4001 CHAR)`, `NVARCHAR(MAX)`, ""},
+		{`VARCHAR2(32767)`, `VARCHAR(MAX)`, ""},
+		{`CHAR`, `CHAR(1)`, ""},
+		{`NVARCHAR2(10)`, `NVARCHAR(10)`, ""},
+		{`RAW(16)`, `VARBINARY(16)`, ""},
+		{`DATE`, `DATETIME2(0)`, ""},
+		{`TIMESTAMP(3)`, `DATETIME2(3)`, ""},
+		{`TIMESTAMP(7)`, `DATETIME2(7)`, ""},
+		{`TIMESTAMP(9)`, `DATETIME2(7)`, "keeps 7 of its 9 fractional digits"},
+		{`TIMESTAMP(9) WITH TIME ZONE`, `DATETIMEOFFSET(7)`, "keeps 7 of its 9 fractional digits"},
+		{`TIMESTAMP(8) WITH LOCAL TIME ZONE`, `DATETIME2(7)`, "keeps 7 of its 8 fractional digits"},
+		{`"SYS"."XMLTYPE"`, `XML`, ""},
+	}
+	m := DefaultTypeMap()
+	for _, test := range tests {
+		t.Run(test._type, func(t *testing.T) {
+			c := parseTable(t, `CREATE TABLE "T" ("C" `+test._type+`);`).Columns.Get("C")
+			got, err := m.Map(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+			note := m.Note(c)
+			if test.note == "" && note != "" || !strings.Contains(note, test.note) {
+				t.Errorf("got note %q, want one containing %q", note, test.note)
+			}
+		})
+	}
+}
+
+func TestTypeMapFile(t *testing.T) {
+	tests := []struct {
+		name string
+		file TypeMapFile
+		want string
+		// part of the error, empty when there is none
+		err string
+	}{
+		{
+			name: "before the defaults",
+			file: TypeMapFile{Rules: []TypeRule{{Type: "NUMBER", MaxPrecision: bound(0), Target: "DECIMAL(38, 10)"}}},
+			want: "DECIMAL(38, 10)",
+		},
+		{
+			name: "placeholders",
+			file: TypeMapFile{Rules: []TypeRule{{Type: "number", Target: "NUMERIC({precision}, {scale})"}}},
+			want: "NUMERIC(0, 0)",
+		},
+		{
+			name: "replace",
+			file: TypeMapFile{Replace: true, Rules: []TypeRule{{Type: "VARCHAR2", Target: "TEXT"}}},
+			err:  "no sql server mapping for column type NUMBER",
+		},
+		{
+			name: "missing target",
+			file: TypeMapFile{Rules: []TypeRule{{Type: "NUMBER"}}},
+			err:  "needs both type and target",
+		},
+	}
+	c := parseTable(t, `CREATE TABLE "T" ("C" NUMBER);`).Columns.Get("C")
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := test.file.TypeMap("test.json")
+			got := ""
+			if err == nil {
+				got, err = m.Map(c)
+			}
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("got error %v, want one containing %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}